@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandAbility(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing ability name")
+	}
+	abilityName := params[0]
+
+	ability, err := config.PokeapiClient.GetAbility(ctx, abilityName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no ability named %s", abilityName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ability (%s): %w", abilityName, err)
+	}
+
+	fmt.Printf("Name: %s\n", ability.Name)
+	if shortEffect := ability.ShortEffect(PokedexLanguage); shortEffect != "" {
+		fmt.Printf("Effect: %s\n", shortEffect)
+	}
+
+	if len(ability.Pokemon) == 0 {
+		fmt.Println("No Pokemon can have this ability")
+		return nil
+	}
+
+	fmt.Println("Pokemon with this ability:")
+	for _, p := range ability.Pokemon {
+		if p.IsHidden {
+			fmt.Printf(" - %s (hidden)\n", p.Pokemon.Name)
+		} else {
+			fmt.Printf(" - %s\n", p.Pokemon.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/inventory"
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+var pokeballCategories = []string{"standard-balls", "special-balls", "apricorn-balls"}
+
+// targetCategories are the item categories that only act on a Pokemon.
+var targetCategories = []string{"healing", "status-cures", "revival", "pp-recovery", "vitamins", "evolution"}
+
+func newStarterBag() *inventory.Inventory {
+	bag := inventory.New()
+	for item, quantity := range starterItems {
+		bag.Add(item, quantity)
+	}
+	return bag
+}
+
+func commandBag(ctx context.Context, config *config, params ...string) error {
+	entries := config.Bag.Entries()
+	if len(entries) == 0 {
+		fmt.Println("your bag is empty")
+		return nil
+	}
+
+	fmt.Println("Your bag:")
+	for _, entry := range entries {
+		fmt.Printf(" - %s x%d\n", entry.Item, entry.Quantity)
+	}
+
+	return nil
+}
+
+func commandUse(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing item name")
+	}
+	itemName := params[0]
+
+	if config.Bag.Count(itemName) == 0 {
+		return fmt.Errorf("you have no %s", itemName)
+	}
+
+	item, err := config.PokeapiClient.GetItem(ctx, itemName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no item named %s", itemName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item (%s): %w", itemName, err)
+	}
+
+	if isPokeball(item) {
+		return fmt.Errorf("throw a %s with: catch <Pokemon name> %s", itemName, itemName)
+	}
+
+	if len(params) < 2 {
+		if !item.HasAttribute("usable-overworld") {
+			return fmt.Errorf("%s can't be used right now", itemName)
+		}
+		if needsTarget(item) {
+			return fmt.Errorf("%s must be used on a Pokemon: use %s <Pokemon name>", itemName, itemName)
+		}
+		// Wild encounters and the map aren't modelled, so field items such as
+		// repel or escape-rope can't change anything either.
+		fmt.Printf("%s had no effect\n", itemName)
+		return nil
+	}
+
+	pokemonName := params[1]
+	pokemon, ok := pokedex[pokemonName]
+	if !ok {
+		fmt.Println("you have not caught that pokemon")
+		return nil
+	}
+
+	evolved, err := evolveWithItem(ctx, config.PokeapiClient, pokemon, itemName)
+	if err != nil {
+		return err
+	}
+	if evolved == nil {
+		// Pokemon have no HP or status here, so only evolutions can change them.
+		fmt.Printf("%s had no effect on %s\n", itemName, pokemonName)
+		return nil
+	}
+	if _, ok := pokedex[evolved.Name]; ok {
+		return fmt.Errorf("you already have a %s", evolved.Name)
+	}
+
+	if err := config.Bag.Remove(itemName, 1); err != nil {
+		return err
+	}
+	delete(pokedex, pokemonName)
+	pokedex[evolved.Name] = *evolved
+	fmt.Printf("You used %s on %s.\n", itemName, pokemonName)
+	fmt.Printf("%s evolved into %s!\n", pokemonName, evolved.Name)
+
+	return nil
+}
+
+func commandGive(ctx context.Context, config *config, params ...string) error {
+	if len(params) < 2 {
+		return fmt.Errorf("missing item or Pokemon name")
+	}
+	itemName := params[0]
+	pokemonName := params[1]
+
+	pokemon, ok := pokedex[pokemonName]
+	if !ok {
+		fmt.Println("you have not caught that pokemon")
+		return nil
+	}
+
+	if config.Bag.Count(itemName) == 0 {
+		return fmt.Errorf("you have no %s", itemName)
+	}
+
+	item, err := config.PokeapiClient.GetItem(ctx, itemName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no item named %s", itemName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item (%s): %w", itemName, err)
+	}
+
+	if !isHoldable(item) {
+		return fmt.Errorf("%s can't be held", itemName)
+	}
+
+	if err := config.Bag.Remove(itemName, 1); err != nil {
+		return err
+	}
+	if pokemon.HeldItem != "" {
+		config.Bag.Add(pokemon.HeldItem, 1)
+		fmt.Printf("%s's %s was put back in the bag.\n", pokemonName, pokemon.HeldItem)
+	}
+
+	pokemon.HeldItem = itemName
+	pokedex[pokemonName] = pokemon
+	fmt.Printf("%s is now holding %s.\n", pokemonName, itemName)
+
+	return nil
+}
+
+// evolveWithItem returns what the Pokemon evolves into when the item is
+// used on it, or nil if the item doesn't trigger an evolution.
+func evolveWithItem(ctx context.Context, client *pokeapi.Client, pokemon caughtPokemon, itemName string) (*caughtPokemon, error) {
+	species, err := pokemon.Species.Resolve(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pokemon species (%s): %w", pokemon.Species.Name, err)
+	}
+
+	evolutionChain, err := species.EvolutionChain.Resolve(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get evolution chain (%s): %w", species.Name, err)
+	}
+
+	evolvedSpecies, ok := findItemEvolution(evolutionChain.Chain, species.Name, itemName)
+	if !ok {
+		return nil, nil
+	}
+
+	evolved, err := client.GetPokemon(ctx, evolvedSpecies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pokemon (%s): %w", evolvedSpecies, err)
+	}
+
+	return &caughtPokemon{
+		PokemonDTO: evolved,
+		HeldItem:   pokemon.HeldItem,
+	}, nil
+}
+
+func findItemEvolution(link pokeapi.ChainLinkDTO, species, itemName string) (string, bool) {
+	if link.Species.Name != species {
+		for _, next := range link.EvolvesTo {
+			if evolved, ok := findItemEvolution(next, species, itemName); ok {
+				return evolved, true
+			}
+		}
+		return "", false
+	}
+
+	for _, next := range link.EvolvesTo {
+		for _, detail := range next.EvolutionDetails {
+			if detail.Trigger.Name == "use-item" && detail.Item != nil && detail.Item.Name == itemName {
+				return next.Species.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+func isPokeball(item pokeapi.ItemDTO) bool {
+	return slices.Contains(pokeballCategories, item.Category.Name)
+}
+
+func needsTarget(item pokeapi.ItemDTO) bool {
+	return slices.Contains(targetCategories, item.Category.Name)
+}
+
+func isHoldable(item pokeapi.ItemDTO) bool {
+	for _, attribute := range item.Attributes {
+		if strings.HasPrefix(attribute.Name, "holdable") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandBerry(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing berry name")
+	}
+	// Berries are named "razz" in the berry endpoint but "razz-berry" as items.
+	berryName := strings.TrimSuffix(params[0], "-berry")
+
+	berry, err := config.PokeapiClient.GetBerry(ctx, berryName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no berry named %s", berryName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get berry (%s): %w", berryName, err)
+	}
+
+	firmness, err := berry.Firmness.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get berry firmness (%s): %w", berry.Firmness.Name, err)
+	}
+
+	item, err := berry.Item.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get item (%s): %w", berry.Item.Name, err)
+	}
+
+	fmt.Printf("Name: %s\n", berry.Item.Name)
+	if shortEffect := item.ShortEffect(PokedexLanguage); shortEffect != "" {
+		fmt.Printf("Effect: %s\n", shortEffect)
+	}
+	fmt.Printf("Firmness: %s\n", firmness.LocalizedName(PokedexLanguage))
+	fmt.Printf("Size: %d mm\n", berry.Size)
+	fmt.Printf("Smoothness: %d\n", berry.Smoothness)
+	fmt.Printf("Growth time: %d hours per stage\n", berry.GrowthTime)
+	fmt.Printf("Max harvest: %d\n", berry.MaxHarvest)
+	fmt.Printf("Natural Gift: %s, power %d\n", berry.NaturalGiftType.Name, berry.NaturalGiftPower)
+	fmt.Println("Flavors:")
+	for _, f := range berry.Flavors {
+		if f.Potency == 0 {
+			continue
+		}
+
+		flavor, err := f.Flavor.Resolve(ctx, config.PokeapiClient)
+		if err != nil {
+			return fmt.Errorf("failed to get berry flavor (%s): %w", f.Flavor.Name, err)
+		}
+		fmt.Printf(" - %s (%s): %d\n", flavor.Name, flavor.ContestType.Name, f.Potency)
+	}
+
+	return nil
+}
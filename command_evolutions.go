@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandEvolutions(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := params[0]
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
+	}
+
+	species, err := pokemon.Species.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon species (%s): %w", pokemon.Species.Name, err)
+	}
+
+	evolutionChain, err := species.EvolutionChain.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get evolution chain (%s): %w", species.Name, err)
+	}
+
+	fmt.Printf("Evolution chain of %s:\n", species.Name)
+	printChainLink(evolutionChain.Chain, species.Name, 0)
+
+	return nil
+}
+
+func printChainLink(link pokeapi.ChainLinkDTO, current string, depth int) {
+	name := link.Species.Name
+	if name == current {
+		name += " *"
+	}
+
+	if depth == 0 {
+		fmt.Println(name)
+	} else {
+		fmt.Printf("%s-> %s (%s)\n", strings.Repeat("  ", depth-1), name, describeEvolution(link.EvolutionDetails))
+	}
+
+	for _, next := range link.EvolvesTo {
+		printChainLink(next, current, depth+1)
+	}
+}
+
+// describeEvolution summarises the ways a Pokemon can evolve into the next
+// stage. Several details mean alternative methods, e.g. in different games.
+func describeEvolution(details []pokeapi.EvolutionDetailDTO) string {
+	if len(details) == 0 {
+		return "unknown"
+	}
+
+	methods := make([]string, 0, len(details))
+	for _, detail := range details {
+		method := describeEvolutionDetail(detail)
+		if !slices.Contains(methods, method) {
+			methods = append(methods, method)
+		}
+	}
+
+	return strings.Join(methods, " or ")
+}
+
+func describeEvolutionDetail(detail pokeapi.EvolutionDetailDTO) string {
+	var conditions []string
+
+	switch detail.Trigger.Name {
+	case "level-up":
+		if detail.MinLevel != nil {
+			conditions = append(conditions, fmt.Sprintf("level %d", *detail.MinLevel))
+		} else {
+			conditions = append(conditions, "level up")
+		}
+	case "use-item":
+		if detail.Item != nil {
+			conditions = append(conditions, "use "+detail.Item.Name)
+		} else {
+			conditions = append(conditions, "use item")
+		}
+	default:
+		conditions = append(conditions, detail.Trigger.Name)
+		if detail.MinLevel != nil {
+			conditions = append(conditions, fmt.Sprintf("from level %d", *detail.MinLevel))
+		}
+	}
+
+	if detail.TradeSpecies != nil {
+		conditions = append(conditions, "for "+detail.TradeSpecies.Name)
+	}
+	if detail.HeldItem != nil {
+		conditions = append(conditions, "holding "+detail.HeldItem.Name)
+	}
+	if detail.KnownMove != nil {
+		conditions = append(conditions, "knowing "+detail.KnownMove.Name)
+	}
+	if detail.KnownMoveType != nil {
+		conditions = append(conditions, "knowing a "+detail.KnownMoveType.Name+" move")
+	}
+	if detail.Location != nil {
+		conditions = append(conditions, "at "+detail.Location.Name)
+	}
+	if detail.MinHappiness != nil {
+		conditions = append(conditions, fmt.Sprintf("happiness %d+", *detail.MinHappiness))
+	}
+	if detail.MinBeauty != nil {
+		conditions = append(conditions, fmt.Sprintf("beauty %d+", *detail.MinBeauty))
+	}
+	if detail.MinAffection != nil {
+		conditions = append(conditions, fmt.Sprintf("affection %d+", *detail.MinAffection))
+	}
+	if detail.TimeOfDay != "" {
+		conditions = append(conditions, "during the "+detail.TimeOfDay)
+	}
+	if detail.Gender != nil {
+		switch *detail.Gender {
+		case 1:
+			conditions = append(conditions, "female")
+		case 2:
+			conditions = append(conditions, "male")
+		}
+	}
+	if detail.RelativePhysicalStats != nil {
+		switch *detail.RelativePhysicalStats {
+		case 1:
+			conditions = append(conditions, "attack > defense")
+		case 0:
+			conditions = append(conditions, "attack = defense")
+		case -1:
+			conditions = append(conditions, "attack < defense")
+		}
+	}
+	if detail.PartySpecies != nil {
+		conditions = append(conditions, "with "+detail.PartySpecies.Name+" in party")
+	}
+	if detail.PartyType != nil {
+		conditions = append(conditions, "with a "+detail.PartyType.Name+" type in party")
+	}
+	if detail.NeedsOverworldRain {
+		conditions = append(conditions, "while raining")
+	}
+	if detail.TurnUpsideDown {
+		conditions = append(conditions, "holding the console upside down")
+	}
+
+	return strings.Join(conditions, ", ")
+}
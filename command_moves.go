@@ -0,0 +1,187 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+var learnMethods = []string{"level-up", "machine", "egg", "tutor"}
+
+type learnsetEntry struct {
+	move   string
+	method string
+	level  int
+}
+
+func commandMoves(ctx context.Context, config *config, params ...string) error {
+	args, flags, err := parseArgs(params, "version-group", "method")
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := args[0]
+
+	method := flags["method"]
+	if method != "" && !slices.Contains(learnMethods, method) {
+		return fmt.Errorf("unknown learn method %s", method)
+	}
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
+	}
+
+	versionGroup := flags["version-group"]
+	if versionGroup == "" && config.VersionGroup != nil {
+		versionGroup = config.VersionGroup.Name
+	}
+	if versionGroup == "" {
+		versionGroup = latestVersionGroup(pokemon)
+	}
+
+	learnset := []learnsetEntry{}
+	for _, move := range pokemon.Moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.Name != versionGroup {
+				continue
+			}
+			if method != "" && detail.MoveLearnMethod.Name != method {
+				continue
+			}
+			learnset = append(learnset, learnsetEntry{
+				move:   move.Move.Name,
+				method: detail.MoveLearnMethod.Name,
+				level:  detail.LevelLearnedAt,
+			})
+		}
+	}
+
+	if len(learnset) == 0 {
+		fmt.Printf("%s learns no moves in %s\n", pokemon.Name, versionGroup)
+		return nil
+	}
+
+	slices.SortFunc(learnset, func(a, b learnsetEntry) int {
+		return cmp.Or(
+			cmp.Compare(learnMethodOrder(a.method), learnMethodOrder(b.method)),
+			cmp.Compare(a.level, b.level),
+			cmp.Compare(a.move, b.move),
+		)
+	})
+
+	moveNames := []string{}
+	for _, entry := range learnset {
+		if !slices.Contains(moveNames, entry.move) {
+			moveNames = append(moveNames, entry.move)
+		}
+	}
+	moves, err := fetchMoves(ctx, config.PokeapiClient, moveNames)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Moves of %s (%s):\n", pokemon.Name, versionGroup)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "METHOD\tLV\tMOVE\tTYPE\tCLASS\tPOWER\tACC\tPP")
+	for _, entry := range learnset {
+		move := moves[entry.move]
+		level := "-"
+		if entry.level > 0 {
+			level = strconv.Itoa(entry.level)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			entry.method,
+			level,
+			entry.move,
+			move.Type.Name,
+			move.DamageClass.Name,
+			formatOptionalInt(move.Power),
+			formatOptionalInt(move.Accuracy),
+			formatOptionalInt(move.PP),
+		)
+	}
+
+	return w.Flush()
+}
+
+// fetchMoves fetches the details of every named move concurrently.
+func fetchMoves(ctx context.Context, client *pokeapi.Client, names []string) (map[string]pokeapi.MoveDTO, error) {
+	moves, err := fetchConcurrently(ctx, names, func(ctx context.Context, name string) (pokeapi.MoveDTO, error) {
+		move, err := client.GetMove(ctx, name)
+		if err != nil {
+			return pokeapi.MoveDTO{}, fmt.Errorf("failed to get move (%s): %w", name, err)
+		}
+		return move, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]pokeapi.MoveDTO, len(moves))
+	for _, move := range moves {
+		byName[move.Name] = move
+	}
+	return byName, nil
+}
+
+// fetchConcurrently calls fetch for every key, with at most
+// fetchConcurrency calls in flight, and returns the results in key order.
+// It fails if any of the calls fails.
+func fetchConcurrently[K, V any](ctx context.Context, keys []K, fetch func(context.Context, K) (V, error)) ([]V, error) {
+	results := pokeapi.Batch(ctx, keys, fetchConcurrency, fetch)
+
+	values := make([]V, len(results))
+	errs := make([]error, len(results))
+	for i, result := range results {
+		values[i], errs[i] = result.Value, result.Err
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// latestVersionGroup returns the most recent version group in which the
+// Pokemon learns any move.
+func latestVersionGroup(pokemon pokeapi.PokemonDTO) string {
+	latest := ""
+	latestID := 0
+	for _, move := range pokemon.Moves {
+		for _, detail := range move.VersionGroupDetails {
+			id, err := detail.VersionGroup.ID()
+			if err == nil && id > latestID {
+				latest = detail.VersionGroup.Name
+				latestID = id
+			}
+		}
+	}
+	return latest
+}
+
+func learnMethodOrder(method string) int {
+	if i := slices.Index(learnMethods, method); i >= 0 {
+		return i
+	}
+	return len(learnMethods)
+}
+
+func formatOptionalInt(value *int) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.Itoa(*value)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandRegions(ctx context.Context, config *config, params ...string) error {
+	fmt.Println("Regions:")
+	for region, err := range pokeapi.List[pokeapi.RegionDTO](ctx, config.PokeapiClient, "region") {
+		if err != nil {
+			return fmt.Errorf("failed to get regions: %w", err)
+		}
+		fmt.Printf(" - %s\n", region.Name)
+	}
+
+	return nil
+}
+
+func commandRegion(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing region name")
+	}
+	regionName := params[0]
+
+	region, err := getRegion(ctx, config.PokeapiClient, regionName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Name: %s\n", region.Name)
+	if region.MainGeneration != nil {
+		fmt.Printf("Main generation: %s\n", region.MainGeneration.Name)
+	}
+	fmt.Println("Version groups:")
+	for _, versionGroup := range region.VersionGroups {
+		fmt.Printf(" - %s\n", versionGroup.Name)
+	}
+	fmt.Println("Pokedexes:")
+	for _, pokedex := range region.Pokedexes {
+		fmt.Printf(" - %s\n", pokedex.Name)
+	}
+	fmt.Printf("Locations: %d (list them with: locations %s)\n", len(region.Locations), region.Name)
+
+	return nil
+}
+
+func commandLocations(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing region name")
+	}
+	regionName := params[0]
+
+	region, err := getRegion(ctx, config.PokeapiClient, regionName)
+	if err != nil {
+		return err
+	}
+
+	if len(region.Locations) == 0 {
+		fmt.Printf("%s has no locations\n", region.Name)
+		return nil
+	}
+
+	fmt.Printf("Locations in %s:\n", region.Name)
+	for _, location := range region.Locations {
+		fmt.Printf(" - %s\n", location.Name)
+	}
+
+	return nil
+}
+
+func commandAreas(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing location name")
+	}
+	locationName := params[0]
+
+	location, err := config.PokeapiClient.GetLocation(ctx, locationName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no location named %s", locationName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get location (%s): %w", locationName, err)
+	}
+
+	if len(location.Areas) == 0 {
+		fmt.Printf("%s has no areas to explore\n", location.Name)
+		return nil
+	}
+
+	fmt.Printf("Areas in %s:\n", location.Name)
+	for _, area := range location.Areas {
+		fmt.Printf(" - %s\n", area.Name)
+	}
+	fmt.Println("Explore one with: explore <area>")
+
+	return nil
+}
+
+func getRegion(ctx context.Context, client *pokeapi.Client, regionName string) (pokeapi.RegionDTO, error) {
+	region, err := client.GetRegion(ctx, regionName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return pokeapi.RegionDTO{}, fmt.Errorf("no region named %s", regionName)
+	}
+	if err != nil {
+		return pokeapi.RegionDTO{}, fmt.Errorf("failed to get region (%s): %w", regionName, err)
+	}
+	return region, nil
+}
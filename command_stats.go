@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+	"github.com/thihxm/gopokedex/internal/statcalc"
+)
+
+const defaultStatsLevel = 50
+
+func commandStats(ctx context.Context, config *config, params ...string) error {
+	args, flags, err := parseArgs(params, "level", "nature", "ivs", "evs")
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := args[0]
+
+	level := defaultStatsLevel
+	if value, ok := flags["level"]; ok {
+		level, err = strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid level %q", value)
+		}
+	}
+	ivs, err := parseStatValues(flags["ivs"], statcalc.MaxIV)
+	if err != nil {
+		return fmt.Errorf("invalid IVs: %w", err)
+	}
+	evs, err := parseStatValues(flags["evs"], 0)
+	if err != nil {
+		return fmt.Errorf("invalid EVs: %w", err)
+	}
+	if err := statcalc.Validate(level, ivs, evs); err != nil {
+		return err
+	}
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
+	}
+
+	nature := statcalc.Nature{}
+	natureDescription := "neutral nature"
+	if natureName, ok := flags["nature"]; ok {
+		natureDTO, err := config.PokeapiClient.GetNature(ctx, natureName)
+		if errors.Is(err, pokeapi.ErrNotFound) {
+			return fmt.Errorf("no nature named %s", natureName)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get nature (%s): %w", natureName, err)
+		}
+
+		natureDescription = natureDTO.Name
+		if natureDTO.IncreasedStat != nil && natureDTO.DecreasedStat != nil {
+			nature.Increased = natureDTO.IncreasedStat.Name
+			nature.Decreased = natureDTO.DecreasedStat.Name
+			// Neutral natures raise and lower the same stat.
+			if nature.Increased != nature.Decreased {
+				natureDescription += fmt.Sprintf(": +%s, -%s", nature.Increased, nature.Decreased)
+			}
+		}
+	}
+
+	baseStats := map[string]int{}
+	for _, stat := range pokemon.Stats {
+		baseStats[stat.Stat.Name] = stat.BaseStat
+	}
+
+	fmt.Printf("Stats of %s at level %d (%s):\n", pokemon.Name, level, natureDescription)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STAT\tBASE\tIV\tEV\tVALUE")
+	for i, stat := range statcalc.Stats {
+		base := baseStats[stat]
+		value := statcalc.Calculate(stat, base, ivs[i], evs[i], level, nature)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", stat, base, ivs[i], evs[i], value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	characteristic, err := findCharacteristic(ctx, config.PokeapiClient, ivs)
+	if err != nil {
+		return err
+	}
+	if description := characteristic.Description(PokedexLanguage); description != "" {
+		fmt.Printf("Characteristic: %s\n", description)
+	}
+
+	return nil
+}
+
+// findCharacteristic returns the characteristic shown in the games for a
+// Pokemon with the given IVs: it depends on the highest IV and that IV's
+// value modulo 5.
+func findCharacteristic(ctx context.Context, client *pokeapi.Client, ivs []int) (pokeapi.CharacteristicDTO, error) {
+	highest := 0
+	for i := range ivs {
+		if ivs[i] > ivs[highest] {
+			highest = i
+		}
+	}
+	statName := statcalc.Stats[highest]
+
+	stat, err := client.GetStat(ctx, statName)
+	if err != nil {
+		return pokeapi.CharacteristicDTO{}, fmt.Errorf("failed to get stat (%s): %w", statName, err)
+	}
+
+	for _, c := range stat.Characteristics {
+		characteristic, err := c.Resolve(ctx, client)
+		if err != nil {
+			return pokeapi.CharacteristicDTO{}, fmt.Errorf("failed to get characteristic (%s): %w", c.URL, err)
+		}
+		if characteristic.GeneModulo == ivs[highest]%5 {
+			return characteristic, nil
+		}
+	}
+
+	return pokeapi.CharacteristicDTO{}, nil
+}
+
+// parseStatValues parses either a single value applied to every stat or a
+// comma-separated list with one value per stat.
+func parseStatValues(value string, fallback int) ([]int, error) {
+	values := make([]int, len(statcalc.Stats))
+	if value == "" {
+		for i := range values {
+			values[i] = fallback
+		}
+		return values, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) != 1 && len(parts) != len(statcalc.Stats) {
+		return nil, fmt.Errorf("expected 1 or %d values, got %d", len(statcalc.Stats), len(parts))
+	}
+
+	for i := range values {
+		part := parts[0]
+		if len(parts) > 1 {
+			part = parts[i]
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", part)
+		}
+		values[i] = n
+	}
+
+	return values, nil
+}
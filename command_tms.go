@@ -0,0 +1,194 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandTM(ctx context.Context, config *config, params ...string) error {
+	args, flags, err := parseArgs(params, "version-group")
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing TM number")
+	}
+
+	itemName, err := machineItemName(args[0])
+	if err != nil {
+		return err
+	}
+
+	item, err := config.PokeapiClient.GetItem(ctx, itemName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no machine named %s", itemName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item (%s): %w", itemName, err)
+	}
+
+	versionGroup := flags["version-group"]
+	if versionGroup == "" && config.VersionGroup != nil {
+		versionGroup = config.VersionGroup.Name
+	}
+
+	links := []pokeapi.APIResource[pokeapi.MachineDTO]{}
+	for _, m := range item.Machines {
+		if versionGroup == "" || m.VersionGroup.Name == versionGroup {
+			links = append(links, m.Machine)
+		}
+	}
+
+	if len(links) == 0 && versionGroup != "" {
+		fmt.Printf("%s does not exist in %s\n", strings.ToUpper(itemName), versionGroup)
+		return nil
+	}
+	if len(links) == 0 {
+		fmt.Printf("%s teaches no moves\n", strings.ToUpper(itemName))
+		return nil
+	}
+
+	machines, err := fetchMachines(ctx, config.PokeapiClient, links)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s teaches:\n", strings.ToUpper(itemName))
+	for _, machine := range machines {
+		fmt.Printf(" - %s in %s\n", machine.Move.Name, machine.VersionGroup.Name)
+	}
+
+	return nil
+}
+
+func commandTMs(ctx context.Context, config *config, params ...string) error {
+	args, flags, err := parseArgs(params, "version-group")
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := args[0]
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
+	}
+
+	versionGroup := flags["version-group"]
+	if versionGroup == "" && config.VersionGroup != nil {
+		versionGroup = config.VersionGroup.Name
+	}
+	if versionGroup == "" {
+		versionGroup = latestVersionGroup(pokemon)
+	}
+
+	moveNames := []string{}
+	for _, move := range pokemon.Moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.Name == versionGroup && detail.MoveLearnMethod.Name == "machine" {
+				moveNames = append(moveNames, move.Move.Name)
+				break
+			}
+		}
+	}
+
+	if len(moveNames) == 0 {
+		fmt.Printf("%s learns no moves from machines in %s\n", pokemon.Name, versionGroup)
+		return nil
+	}
+
+	moves, err := fetchMoves(ctx, config.PokeapiClient, moveNames)
+	if err != nil {
+		return err
+	}
+
+	links := []pokeapi.APIResource[pokeapi.MachineDTO]{}
+	for _, name := range moveNames {
+		for _, m := range moves[name].Machines {
+			if m.VersionGroup.Name == versionGroup {
+				links = append(links, m.Machine)
+			}
+		}
+	}
+
+	machines, err := fetchMachines(ctx, config.PokeapiClient, links)
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(machines, func(a, b pokeapi.MachineDTO) int {
+		aKind, aNumber := machineOrder(a.Item.Name)
+		bKind, bNumber := machineOrder(b.Item.Name)
+		return cmp.Or(
+			cmp.Compare(aKind, bKind),
+			cmp.Compare(aNumber, bNumber),
+			cmp.Compare(a.Item.Name, b.Item.Name),
+		)
+	})
+
+	fmt.Printf("Machines compatible with %s (%s):\n", pokemon.Name, versionGroup)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MACHINE\tMOVE\tTYPE")
+	for _, machine := range machines {
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			strings.ToUpper(machine.Item.Name),
+			machine.Move.Name,
+			moves[machine.Move.Name].Type.Name,
+		)
+	}
+
+	return w.Flush()
+}
+
+func fetchMachines(ctx context.Context, client *pokeapi.Client, links []pokeapi.APIResource[pokeapi.MachineDTO]) ([]pokeapi.MachineDTO, error) {
+	return fetchConcurrently(ctx, links, func(ctx context.Context, link pokeapi.APIResource[pokeapi.MachineDTO]) (pokeapi.MachineDTO, error) {
+		machine, err := link.Resolve(ctx, client)
+		if err != nil {
+			return pokeapi.MachineDTO{}, fmt.Errorf("failed to get machine (%s): %w", link.URL, err)
+		}
+		return machine, nil
+	})
+}
+
+// machineItemName turns user input such as "24", "tm24" or "hm05" into the
+// machine's item name.
+func machineItemName(input string) (string, error) {
+	prefix := "tm"
+	number := input
+	if strings.HasPrefix(input, "tm") || strings.HasPrefix(input, "hm") {
+		prefix = input[:2]
+		number = input[2:]
+	}
+
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		return "", fmt.Errorf("invalid machine number %q", input)
+	}
+
+	return fmt.Sprintf("%s%02d", prefix, n), nil
+}
+
+// machineOrder sorts TMs before HMs and then by number.
+func machineOrder(itemName string) (int, int) {
+	kind := 0
+	if strings.HasPrefix(itemName, "hm") {
+		kind = 1
+	}
+
+	number, _ := strconv.Atoi(strings.TrimLeft(itemName, "thrm"))
+	return kind, number
+}
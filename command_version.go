@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+func commandVersion(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		if config.Version == nil {
+			fmt.Println("No version selected, showing data from every generation")
+			return nil
+		}
+		fmt.Printf("Version: %s (%s, %s)\n", config.Version.Name, config.VersionGroup.Name, config.VersionGroup.Generation.Name)
+		return nil
+	}
+	versionName := params[0]
+
+	if versionName == "all" {
+		config.Version = nil
+		config.VersionGroup = nil
+		fmt.Println("Version cleared, showing data from every generation")
+		return nil
+	}
+
+	version, err := config.PokeapiClient.GetVersion(ctx, versionName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no version named %s", versionName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get version (%s): %w", versionName, err)
+	}
+
+	versionGroup, err := version.VersionGroup.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get version group (%s): %w", version.VersionGroup.Name, err)
+	}
+
+	config.Version = &version
+	config.VersionGroup = &versionGroup
+	fmt.Printf("Version set to %s (%s, %s)\n", version.Name, versionGroup.Name, versionGroup.Generation.Name)
+
+	return nil
+}
+
+// selectedGeneration returns the ID of the generation of the selected
+// version, if any.
+func selectedGeneration(config *config) (int, bool) {
+	if config.VersionGroup == nil {
+		return 0, false
+	}
+
+	id, err := config.VersionGroup.Generation.ID()
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// typesInGeneration returns the Pokemon's type names as they were in the
+// given generation. PastTypes entries hold the types a Pokemon had up to and
+// including their generation, so the earliest one not before it wins.
+func typesInGeneration(pokemon pokeapi.PokemonDTO, generation int) []string {
+	past := -1
+	pastGeneration := 0
+	for i, pastTypes := range pokemon.PastTypes {
+		id, err := pastTypes.Generation.ID()
+		if err != nil || id < generation {
+			continue
+		}
+		if past == -1 || id < pastGeneration {
+			past = i
+			pastGeneration = id
+		}
+	}
+
+	names := []string{}
+	if past >= 0 {
+		for _, t := range pokemon.PastTypes[past].Types {
+			names = append(names, t.Type.Name)
+		}
+		return names
+	}
+
+	for _, t := range pokemon.Types {
+		names = append(names, t.Type.Name)
+	}
+	return names
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+	"github.com/thihxm/gopokedex/internal/typechart"
+)
+
+func commandWeakness(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := params[0]
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
+	}
+
+	typeNames := make([]string, 0, len(pokemon.Types))
+	types := make([]pokeapi.TypeDTO, 0, len(pokemon.Types))
+	for _, t := range pokemon.Types {
+		pokemonType, err := t.Type.Resolve(ctx, config.PokeapiClient)
+		if err != nil {
+			return fmt.Errorf("failed to get type (%s): %w", t.Type.Name, err)
+		}
+		typeNames = append(typeNames, pokemonType.Name)
+		types = append(types, pokemonType)
+	}
+
+	matrix := typechart.FromTypes(types...)
+
+	fmt.Printf("Damage taken by %s (%s):\n", pokemon.Name, strings.Join(typeNames, "/"))
+	for _, bucket := range matrix.Defense(typeNames...) {
+		multiplier := strconv.FormatFloat(bucket.Multiplier, 'f', -1, 64)
+		fmt.Printf(" - %sx: %s\n", multiplier, strings.Join(bucket.Types, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+type encounterSummary struct {
+	version    string
+	versionID  int
+	method     string
+	area       string
+	conditions string
+	minLevel   int
+	maxLevel   int
+	chance     int
+}
+
+func commandWhere(ctx context.Context, config *config, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("missing Pokemon name")
+	}
+	pokemonName := params[0]
+
+	encounters, err := config.PokeapiClient.GetPokemonEncounters(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get encounters (%s): %w", pokemonName, err)
+	}
+
+	summaries := summarizeEncounters(encounters)
+	if config.Version != nil {
+		summaries = slices.DeleteFunc(summaries, func(s encounterSummary) bool {
+			return s.version != config.Version.Name
+		})
+	}
+
+	if len(summaries) == 0 {
+		if config.Version != nil {
+			fmt.Printf("%s can't be found in the wild in %s\n", pokemonName, config.Version.Name)
+		} else {
+			fmt.Printf("%s can't be found in the wild\n", pokemonName)
+		}
+		return nil
+	}
+
+	fmt.Printf("Where to find %s:\n", pokemonName)
+	for i, s := range summaries {
+		if i == 0 || s.version != summaries[i-1].version {
+			fmt.Printf("%s:\n", s.version)
+		}
+		if i == 0 || s.version != summaries[i-1].version || s.method != summaries[i-1].method {
+			fmt.Printf("  %s:\n", s.method)
+		}
+
+		levels := fmt.Sprintf("lv %d", s.minLevel)
+		if s.maxLevel != s.minLevel {
+			levels = fmt.Sprintf("lv %d-%d", s.minLevel, s.maxLevel)
+		}
+		if s.conditions != "" {
+			fmt.Printf("   - %s (%s, %d%%, %s)\n", s.area, levels, s.chance, s.conditions)
+		} else {
+			fmt.Printf("   - %s (%s, %d%%)\n", s.area, levels, s.chance)
+		}
+	}
+
+	return nil
+}
+
+// summarizeEncounters merges the encounter details of each area by version,
+// method and conditions, adding up the chances and widening the level range,
+// and sorts them in game order. Details with different conditions (time of
+// day, swarms, ...) are alternatives, so they are kept apart.
+func summarizeEncounters(encounters []pokeapi.LocationAreaEncounterDTO) []encounterSummary {
+	type key struct {
+		version    string
+		method     string
+		area       string
+		conditions string
+	}
+
+	byKey := map[key]*encounterSummary{}
+	summaries := []*encounterSummary{}
+	for _, encounter := range encounters {
+		for _, versionDetails := range encounter.VersionDetails {
+			versionID, _ := versionDetails.Version.ID()
+			for _, detail := range versionDetails.EncounterDetails {
+				conditions := []string{}
+				for _, condition := range detail.ConditionValues {
+					conditions = append(conditions, condition.Name)
+				}
+				slices.Sort(conditions)
+
+				k := key{
+					version:    versionDetails.Version.Name,
+					method:     detail.Method.Name,
+					area:       encounter.LocationArea.Name,
+					conditions: strings.Join(conditions, ", "),
+				}
+
+				summary, ok := byKey[k]
+				if !ok {
+					summary = &encounterSummary{
+						version:    k.version,
+						versionID:  versionID,
+						method:     k.method,
+						area:       k.area,
+						conditions: k.conditions,
+						minLevel:   detail.MinLevel,
+						maxLevel:   detail.MaxLevel,
+					}
+					byKey[k] = summary
+					summaries = append(summaries, summary)
+				}
+
+				summary.minLevel = min(summary.minLevel, detail.MinLevel)
+				summary.maxLevel = max(summary.maxLevel, detail.MaxLevel)
+				summary.chance += detail.Chance
+			}
+		}
+	}
+
+	result := make([]encounterSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, *summary)
+	}
+
+	slices.SortFunc(result, func(a, b encounterSummary) int {
+		return cmp.Or(
+			cmp.Compare(a.versionID, b.versionID),
+			cmp.Compare(a.method, b.method),
+			cmp.Compare(a.area, b.area),
+			cmp.Compare(a.conditions, b.conditions),
+		)
+	})
+
+	return result
+}
@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Inventory is the player's bag: how many of each item, keyed by the
+// item's PokeAPI name.
+type Inventory struct {
+	items map[string]int
+}
+
+type Entry struct {
+	Item     string
+	Quantity int
+}
+
+func New() *Inventory {
+	return &Inventory{
+		items: make(map[string]int),
+	}
+}
+
+func (inv *Inventory) Add(item string, quantity int) {
+	if quantity <= 0 {
+		return
+	}
+	inv.items[item] += quantity
+}
+
+// Remove takes quantity items out of the bag, failing without changing
+// anything if there aren't enough.
+func (inv *Inventory) Remove(item string, quantity int) error {
+	count := inv.items[item]
+	if count < quantity {
+		return fmt.Errorf("not enough %s (have %d, need %d)", item, count, quantity)
+	}
+
+	if count == quantity {
+		delete(inv.items, item)
+	} else {
+		inv.items[item] = count - quantity
+	}
+	return nil
+}
+
+func (inv *Inventory) Count(item string) int {
+	return inv.items[item]
+}
+
+// Entries returns the bag's contents sorted by item name.
+func (inv *Inventory) Entries() []Entry {
+	entries := make([]Entry, 0, len(inv.items))
+	for item, quantity := range inv.items {
+		entries = append(entries, Entry{
+			Item:     item,
+			Quantity: quantity,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Item < entries[j].Item
+	})
+	return entries
+}
@@ -0,0 +1,47 @@
+package inventory
+
+import (
+	"testing"
+)
+
+func TestAddRemove(t *testing.T) {
+	inv := New()
+	inv.Add("poke-ball", 5)
+	inv.Add("potion", 1)
+	inv.Add("poke-ball", 2)
+
+	if count := inv.Count("poke-ball"); count != 7 {
+		t.Errorf("expected 7 poke-balls, got %d", count)
+	}
+
+	if err := inv.Remove("poke-ball", 8); err == nil {
+		t.Errorf("expected error removing more than available")
+	}
+	if count := inv.Count("poke-ball"); count != 7 {
+		t.Errorf("expected failed removal to leave 7 poke-balls, got %d", count)
+	}
+
+	if err := inv.Remove("potion", 1); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	entries := inv.Entries()
+	if len(entries) != 1 || entries[0] != (Entry{Item: "poke-ball", Quantity: 7}) {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+}
+
+func TestEntriesSorted(t *testing.T) {
+	inv := New()
+	inv.Add("ultra-ball", 1)
+	inv.Add("great-ball", 1)
+	inv.Add("poke-ball", 1)
+
+	expected := []string{"great-ball", "poke-ball", "ultra-ball"}
+	entries := inv.Entries()
+	for i, entry := range entries {
+		if entry.Item != expected[i] {
+			t.Errorf("entries[%d] == %q, expected %q", i, entry.Item, expected[i])
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchResult holds the outcome of one item of a batch.
+type BatchResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// Batch calls fn for every input with at most concurrency calls in flight
+// and returns the results in input order. A failing item doesn't stop the
+// others; its error is reported in its own result. A non-positive
+// concurrency runs every call at once.
+func Batch[In, Out any](ctx context.Context, inputs []In, concurrency int, fn func(context.Context, In) (Out, error)) []BatchResult[Out] {
+	results := make([]BatchResult[Out], len(inputs))
+	if concurrency <= 0 || concurrency > len(inputs) {
+		concurrency = len(inputs)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					results[i].Err = err
+					continue
+				}
+				results[i].Value, results[i].Err = fn(ctx, inputs[i])
+			}
+		}()
+	}
+
+	for i := range inputs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// GetPokemonBatch fetches several Pokemon concurrently, sharing the client's
+// cache and rate limiter. Results are in the same order as names.
+func (c *Client) GetPokemonBatch(ctx context.Context, names []string, concurrency int) []BatchResult[PokemonDTO] {
+	return Batch(ctx, names, concurrency, c.GetPokemon)
+}
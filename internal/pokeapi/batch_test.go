@@ -0,0 +1,116 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	inputs := []int{5, 1, 4, 2, 3}
+	errOdd := errors.New("odd")
+
+	results := Batch(context.Background(), inputs, 2, func(ctx context.Context, n int) (int, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			peak := maxInFlight.Load()
+			if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+		return n * 10, nil
+	})
+
+	if len(results) != len(inputs) {
+		t.Fatalf("expected %d results, got %d", len(inputs), len(results))
+	}
+	for i, n := range inputs {
+		if n%2 == 1 {
+			if !errors.Is(results[i].Err, errOdd) {
+				t.Errorf("results[%d]: expected odd error, got %v", i, results[i].Err)
+			}
+			continue
+		}
+		if results[i].Err != nil || results[i].Value != n*10 {
+			t.Errorf("results[%d] == %+v, expected %d", i, results[i], n*10)
+		}
+	}
+	if peak := maxInFlight.Load(); peak > 2 {
+		t.Errorf("expected at most 2 calls in flight, got %d", peak)
+	}
+}
+
+func TestBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	results := Batch(ctx, []string{"a", "b"}, 1, func(ctx context.Context, s string) (string, error) {
+		calls++
+		return s, nil
+	})
+
+	if calls != 0 {
+		t.Errorf("expected no calls after cancellation, got %d", calls)
+	}
+	for i, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("results[%d]: expected context.Canceled, got %v", i, result.Err)
+		}
+	}
+}
+
+func TestGetPokemonBatch(t *testing.T) {
+	var mu sync.Mutex
+	hits := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/pokemon/")
+		mu.Lock()
+		hits[name]++
+		mu.Unlock()
+
+		if name == "missingno" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"name": %q}`, name)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(WithBaseURL(server.URL))
+	names := []string{"pidgey", "missingno", "rattata", "spearow"}
+
+	for range 2 {
+		results := client.GetPokemonBatch(ctx, names, 3)
+		for i, name := range names {
+			if name == "missingno" {
+				if !errors.Is(results[i].Err, ErrNotFound) {
+					t.Errorf("expected ErrNotFound for %s, got %v", name, results[i].Err)
+				}
+				continue
+			}
+			if results[i].Err != nil || results[i].Value.Name != name {
+				t.Errorf("results[%d] == %+v, expected %s", i, results[i], name)
+			}
+		}
+	}
+
+	if hits["pidgey"] != 1 || hits["rattata"] != 1 || hits["spearow"] != 1 {
+		t.Errorf("expected batches to share the cache, got %v", hits)
+	}
+}
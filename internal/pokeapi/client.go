@@ -0,0 +1,145 @@
+package pokeapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/thihxm/gopokedex/internal/pokecache"
+)
+
+const (
+	defaultUserAgent     = "gopokedex"
+	defaultTimeout       = 10 * time.Second
+	defaultCacheInterval = 5 * time.Minute
+)
+
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	cache       *pokecache.Cache
+	userAgent   string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
+	inflight    flightGroup
+}
+
+type Option func(*Client)
+
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+func WithCache(cache *pokecache.Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:     baseURL,
+		userAgent:   defaultUserAgent,
+		retryPolicy: DefaultRetryPolicy,
+		limiter:     newRateLimiter(defaultRequestsPerSecond, defaultBurst),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	if c.timeout > 0 {
+		// Copy the client so the timeout doesn't leak into the caller's one.
+		httpClient := *c.httpClient
+		httpClient.Timeout = c.timeout
+		c.httpClient = &httpClient
+	}
+
+	if c.cache == nil {
+		c.cache = pokecache.NewCache(defaultCacheInterval)
+	}
+
+	return c
+}
+
+// get performs a GET request, retrying transport errors and retryable status
+// codes according to the client's retry policy. Non-zero validators make the
+// request conditional, in which case a 304 Not Modified is returned as is.
+// Any other non-2xx response that is not retried is turned into an
+// *HTTPError.
+func (c *Client) get(ctx context.Context, url string, validators pokecache.Validators) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := c.do(ctx, url, validators)
+		if err == nil && res.StatusCode >= 200 && res.StatusCode <= 299 {
+			return res, nil
+		}
+		if err == nil && res.StatusCode == http.StatusNotModified && !validators.IsZero() {
+			return res, nil
+		}
+
+		if err == nil {
+			res.Body.Close()
+			err = &HTTPError{
+				StatusCode: res.StatusCode,
+				URL:        url,
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		delay, ok := c.retryPolicy.delay(attempt, res)
+		if !ok {
+			return nil, err
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) do(ctx context.Context, url string, validators pokecache.Validators) (*http.Response, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	return c.httpClient.Do(req)
+}
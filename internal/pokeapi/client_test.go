@@ -0,0 +1,159 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/thihxm/gopokedex/internal/pokecache"
+)
+
+func TestClientOptions(t *testing.T) {
+	hits := 0
+	userAgent := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		userAgent = r.Header.Get("User-Agent")
+		if r.URL.Path != "/pokemon/pikachu" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": 25, "name": "pikachu", "base_experience": 112}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithUserAgent("gopokedex-test"),
+		WithCache(pokecache.NewCache(time.Minute)),
+	)
+
+	for range 2 {
+		pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pokemon.Name != "pikachu" || pokemon.BaseExperience != 112 {
+			t.Errorf("unexpected pokemon %+v", pokemon)
+		}
+	}
+
+	if hits != 1 {
+		t.Errorf("expected 1 request to reach the server, got %d", hits)
+	}
+	if userAgent != "gopokedex-test" {
+		t.Errorf("expected user agent %q, got %q", "gopokedex-test", userAgent)
+	}
+
+	isolated := NewClient(WithBaseURL(server.URL))
+	if _, err := isolated.GetPokemon(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hits != 2 {
+		t.Errorf("expected clients to have isolated caches, got %d requests", hits)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	httpClient := &http.Client{}
+	client := NewClient(WithHTTPClient(httpClient), WithTimeout(time.Second))
+
+	if client.httpClient.Timeout != time.Second {
+		t.Errorf("expected timeout %v, got %v", time.Second, client.httpClient.Timeout)
+	}
+	if httpClient.Timeout != 0 {
+		t.Errorf("expected caller's http client to be left untouched")
+	}
+}
+
+func TestClientStatusErrors(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		switch r.URL.Path {
+		case "/pokemon/pikchu":
+			http.Error(w, "Not Found", http.StatusNotFound)
+		case "/pokemon/pikachu":
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 1}),
+	)
+
+	_, err := client.GetPokemon(context.Background(), "pikchu")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	for range 2 {
+		_, err = client.GetPokemon(context.Background(), "pikachu")
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) {
+			t.Fatalf("expected *HTTPError, got %v", err)
+		}
+		if httpErr.StatusCode != http.StatusBadGateway || httpErr.URL != server.URL+"/pokemon/pikachu" {
+			t.Errorf("unexpected error %+v", httpErr)
+		}
+	}
+
+	if hits != 3 {
+		t.Errorf("expected error responses not to be cached, got %d requests", hits)
+	}
+}
+
+func TestClientRevalidation(t *testing.T) {
+	const lastModified = "Mon, 02 Jan 2006 15:04:05 GMT"
+	full := 0
+	etag := `"v1"`
+	revalidatedWith := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag && r.Header.Get("If-Modified-Since") == lastModified {
+			revalidatedWith = append(revalidatedWith, etag)
+			// The representation is unchanged but the server moved to a new ETag.
+			etag = fmt.Sprintf(`"v%d"`, len(revalidatedWith)+1)
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		w.Write([]byte(`{"id": 25, "name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	const interval = 5 * time.Millisecond
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithCache(pokecache.NewCache(interval)),
+	)
+
+	for range 3 {
+		pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pokemon.Name != "pikachu" {
+			t.Errorf("unexpected pokemon %+v", pokemon)
+		}
+		time.Sleep(interval + 5*time.Millisecond)
+	}
+
+	if full != 1 {
+		t.Errorf("expected 1 full response, got %d", full)
+	}
+	if expected := []string{`"v1"`, `"v2"`}; !slices.Equal(revalidatedWith, expected) {
+		t.Errorf("expected revalidations with %v, got %v", expected, revalidatedWith)
+	}
+	if _, ok := client.cache.Get(server.URL + "/pokemon/pikachu"); ok {
+		t.Errorf("expected entry to expire again after the refresh interval")
+	}
+}
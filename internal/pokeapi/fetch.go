@@ -0,0 +1,94 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thihxm/gopokedex/internal/pokecache"
+)
+
+// fetch GETs rawURL through the client's cache and decodes the JSON body
+// into T. Every endpoint goes through here.
+func fetch[T any](ctx context.Context, c *Client, rawURL string) (T, error) {
+	var zero T
+
+	data, err := c.getBytes(ctx, rawURL)
+	if err != nil {
+		return zero, err
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return zero, err
+	}
+
+	return v, nil
+}
+
+// getBytes returns the body of rawURL, from the cache when possible.
+// Concurrent misses for the same URL share a single request.
+func (c *Client) getBytes(ctx context.Context, rawURL string) ([]byte, error) {
+	if data, ok := c.cache.Get(rawURL); ok {
+		return data, nil
+	}
+
+	return c.inflight.do(ctx, rawURL, func() ([]byte, error) {
+		// Another caller may have filled the cache while we waited.
+		if data, ok := c.cache.Get(rawURL); ok {
+			return data, nil
+		}
+		return c.download(ctx, rawURL)
+	})
+}
+
+// download fetches rawURL and stores the body in the cache. When the cache
+// holds an expired copy with validators, the request is made conditional
+// and a 304 Not Modified refreshes that copy instead.
+func (c *Client) download(ctx context.Context, rawURL string) ([]byte, error) {
+	stale, validators, hasStale := c.cache.Stale(rawURL)
+
+	res, err := c.get(ctx, rawURL, validators)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	responseValidators := pokecache.Validators{
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}
+
+	if res.StatusCode == http.StatusNotModified {
+		if !hasStale || !c.cache.Refresh(rawURL, responseValidators) {
+			return nil, &HTTPError{
+				StatusCode: res.StatusCode,
+				URL:        rawURL,
+			}
+		}
+		return stale, nil
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.AddWithValidators(rawURL, data, responseValidators)
+
+	return data, nil
+}
+
+// endpoint builds an API URL from path segments, e.g. endpoint("pokemon",
+// "pikachu").
+func (c *Client) endpoint(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = url.PathEscape(segment)
+	}
+
+	return c.baseURL + "/" + strings.Join(escaped, "/")
+}
@@ -0,0 +1,106 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestCoalescing(t *testing.T) {
+	var hits atomic.Int32
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		entered <- struct{}{}
+		<-release
+		w.Write([]byte(`{"id": 25, "name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	const callers = 10
+	var wg sync.WaitGroup
+	names := make([]string, callers)
+	errs := make([]error, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+			names[i], errs[i] = pokemon.Name, err
+		}()
+	}
+
+	<-entered
+	// Give the remaining callers time to join the in-flight request.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i := range callers {
+		if errs[i] != nil || names[i] != "pikachu" {
+			t.Errorf("caller %d got %q, %v", i, names[i], errs[i])
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("expected 1 request to reach the server, got %d", n)
+	}
+}
+
+func TestRequestCoalescingCancelledLeader(t *testing.T) {
+	var hits atomic.Int32
+	entered := make(chan struct{}, 2)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		entered <- struct{}{}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte(`{"id": 25, "name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetPokemon(leaderCtx, "pikachu")
+		leaderErr <- err
+	}()
+	<-entered
+
+	waiterDone := make(chan error, 1)
+	go func() {
+		pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+		if err == nil && pokemon.Name != "pikachu" {
+			t.Errorf("unexpected pokemon %+v", pokemon)
+		}
+		waiterDone <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected leader to be cancelled, got %v", err)
+	}
+
+	<-entered
+	close(release)
+	if err := <-waiterDone; err != nil {
+		t.Errorf("expected waiter to take over the request, got %v", err)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("expected 2 requests to reach the server, got %d", n)
+	}
+}
@@ -0,0 +1,80 @@
+package pokeapi
+
+import "strings"
+
+// NameDTO is a resource's name in one language.
+type NameDTO struct {
+	Name     string                        `json:"name"`
+	Language NamedAPIResource[ResourceDTO] `json:"language"`
+}
+
+// DescriptionDTO is a resource's description in one language.
+type DescriptionDTO struct {
+	Description string                        `json:"description"`
+	Language    NamedAPIResource[ResourceDTO] `json:"language"`
+}
+
+// GenusDTO is a species' genus in one language.
+type GenusDTO struct {
+	Genus    string                        `json:"genus"`
+	Language NamedAPIResource[ResourceDTO] `json:"language"`
+}
+
+// VerboseEffectDTO is the effect of a move, ability or item in one
+// language.
+type VerboseEffectDTO struct {
+	Effect      string                        `json:"effect"`
+	ShortEffect string                        `json:"short_effect"`
+	Language    NamedAPIResource[ResourceDTO] `json:"language"`
+}
+
+// FlavorTextDTO is a game text in one language. Species entries are tied to
+// a version, move and ability entries to a version group.
+type FlavorTextDTO struct {
+	FlavorText   string                             `json:"flavor_text"`
+	Language     NamedAPIResource[ResourceDTO]      `json:"language"`
+	Version      *NamedAPIResource[VersionDTO]      `json:"version"`
+	VersionGroup *NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+}
+
+func (n NameDTO) language() string          { return n.Language.Name }
+func (d DescriptionDTO) language() string   { return d.Language.Name }
+func (g GenusDTO) language() string         { return g.Language.Name }
+func (e VerboseEffectDTO) language() string { return e.Language.Name }
+func (f FlavorTextDTO) language() string    { return f.Language.Name }
+
+type localized interface {
+	language() string
+}
+
+// inLanguage returns the first entry in the given language.
+func inLanguage[T localized](entries []T, language string) (T, bool) {
+	for _, entry := range entries {
+		if entry.language() == language {
+			return entry, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// localizedName returns the name in the given language, falling back to
+// the resource's API name.
+func localizedName(names []NameDTO, language, fallback string) string {
+	if name, ok := inLanguage(names, language); ok {
+		return name.Name
+	}
+	return fallback
+}
+
+// latestFlavorText returns the most recent entry in the given language with
+// its line breaks collapsed, or an empty string if there is none. Entries
+// are listed oldest first.
+func latestFlavorText(entries []FlavorTextDTO, language string) string {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].language() == language {
+			return strings.Join(strings.Fields(entries[i].FlavorText), " ")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package pokeapi
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// NamedAPIResourceList is one page of a PokeAPI list endpoint.
+type NamedAPIResourceList[T any] struct {
+	Count    int                   `json:"count"`
+	Next     *string               `json:"next"`
+	Previous *string               `json:"previous"`
+	Results  []NamedAPIResource[T] `json:"results"`
+}
+
+type listOptions struct {
+	limit  int
+	offset int
+}
+
+type ListOption func(*listOptions)
+
+// Limit sets how many resources are fetched per page.
+func Limit(limit int) ListOption {
+	return func(o *listOptions) {
+		o.limit = limit
+	}
+}
+
+// Offset skips the first offset resources of the list.
+func Offset(offset int) ListOption {
+	return func(o *listOptions) {
+		o.offset = offset
+	}
+}
+
+// List iterates over every resource of a list endpoint such as "pokemon" or
+// "region", following next links until the list ends or the caller stops.
+// A failed page fetch is yielded as an error and ends the iteration.
+func List[T any](ctx context.Context, c *Client, endpoint string, opts ...ListOption) iter.Seq2[NamedAPIResource[T], error] {
+	options := listOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return func(yield func(NamedAPIResource[T], error) bool) {
+		pageURL := c.listURL(endpoint, options)
+		for {
+			page, err := fetch[NamedAPIResourceList[T]](ctx, c, pageURL)
+			if err != nil {
+				yield(NamedAPIResource[T]{}, err)
+				return
+			}
+
+			for _, resource := range page.Results {
+				if !yield(resource, nil) {
+					return
+				}
+			}
+
+			if page.Next == nil {
+				return
+			}
+			pageURL = *page.Next
+		}
+	}
+}
+
+// List iterates over every resource of a list endpoint without tying the
+// links to a specific DTO. Use the List function for typed links.
+func (c *Client) List(ctx context.Context, endpoint string, opts ...ListOption) iter.Seq2[NamedAPIResource[ResourceDTO], error] {
+	return List[ResourceDTO](ctx, c, endpoint, opts...)
+}
+
+func (c *Client) listURL(endpoint string, options listOptions) string {
+	query := url.Values{}
+	if options.limit > 0 {
+		query.Set("limit", strconv.Itoa(options.limit))
+	}
+	if options.offset > 0 {
+		query.Set("offset", strconv.Itoa(options.offset))
+	}
+
+	listURL := c.endpoint(endpoint)
+	if len(query) > 0 {
+		listURL += "?" + query.Encode()
+	}
+	return listURL
+}
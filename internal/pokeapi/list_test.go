@@ -0,0 +1,80 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	names := []string{"bulbasaur", "ivysaur", "venusaur", "charmander", "charmeleon"}
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pokemon" {
+			http.NotFound(w, r)
+			return
+		}
+		pages++
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if limit == 0 {
+			limit = 20
+		}
+		end := min(offset+limit, len(names))
+
+		results := []string{}
+		for i := offset; i < end; i++ {
+			results = append(results, fmt.Sprintf(`{"name": %q, "url": "http://%s/pokemon/%d/"}`, names[i], r.Host, i+1))
+		}
+		next := "null"
+		if end < len(names) {
+			next = fmt.Sprintf(`"http://%s/pokemon?limit=%d&offset=%d"`, r.Host, limit, end)
+		}
+		fmt.Fprintf(w, `{"count": %d, "next": %s, "previous": null, "results": [%s]}`, len(names), next, strings.Join(results, ","))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(WithBaseURL(server.URL))
+
+	got := []string{}
+	for pokemon, err := range client.List(ctx, "pokemon", Limit(2), Offset(1)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, pokemon.Name)
+	}
+	if !slices.Equal(got, names[1:]) {
+		t.Errorf("expected %v, got %v", names[1:], got)
+	}
+	if pages != 2 {
+		t.Errorf("expected 2 pages to be fetched, got %d", pages)
+	}
+
+	pages = 0
+	for pokemon, err := range List[PokemonDTO](ctx, client, "pokemon", Limit(3), Offset(2)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id, err := pokemon.ID(); err != nil || id != 3 {
+			t.Errorf("ID() == %d, %v, expected 3", id, err)
+		}
+		break
+	}
+	if pages != 1 {
+		t.Errorf("expected iteration to stop after the first page, got %d pages", pages)
+	}
+
+	for _, err := range client.List(ctx, "pokmon") {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	}
+}
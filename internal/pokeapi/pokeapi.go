@@ -1,118 +1,115 @@
 package pokeapi
 
 import (
-	"encoding/json"
-	"io"
-	"net/http"
-	"time"
-
-	"github.com/thihxm/gopokedex/internal/pokecache"
+	"context"
+	"strconv"
 )
 
 const (
 	baseURL = "https://pokeapi.co/api/v2"
 )
 
-var cache = pokecache.NewCache(5 * time.Minute)
-
-func GetLocationArea(url *string) (LocationAreaDTO, error) {
-	locationUrl := baseURL + "/location-area"
+func (c *Client) GetLocationArea(ctx context.Context, url *string) (NamedAPIResourceList[LocationAreaDetailsDTO], error) {
+	locationUrl := c.endpoint("location-area")
 	if url != nil {
 		locationUrl = *url
 	}
 
-	var locationArea LocationAreaDTO
-	if cacheEntry, ok := cache.Get(locationUrl); ok {
-		err := json.Unmarshal(cacheEntry, &locationArea)
-		if err != nil {
-			return LocationAreaDTO{}, err
-		}
-		return locationArea, nil
-	}
+	return fetch[NamedAPIResourceList[LocationAreaDetailsDTO]](ctx, c, locationUrl)
+}
 
-	res, err := http.Get(locationUrl)
-	if err != nil {
-		return LocationAreaDTO{}, err
-	}
-	defer res.Body.Close()
+func (c *Client) GetLocationAreaDetails(ctx context.Context, areaOrID string) (LocationAreaDetailsDTO, error) {
+	return fetch[LocationAreaDetailsDTO](ctx, c, c.endpoint("location-area", areaOrID))
+}
 
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return LocationAreaDTO{}, err
-	}
+func (c *Client) GetPokemon(ctx context.Context, pokemonNameOrID string) (PokemonDTO, error) {
+	return fetch[PokemonDTO](ctx, c, c.endpoint("pokemon", pokemonNameOrID))
+}
 
-	cache.Add(locationUrl, data)
+func (c *Client) GetPokemonSpecies(ctx context.Context, speciesNameOrID string) (PokemonSpeciesDTO, error) {
+	return fetch[PokemonSpeciesDTO](ctx, c, c.endpoint("pokemon-species", speciesNameOrID))
+}
 
-	err = json.Unmarshal(data, &locationArea)
-	if err != nil {
-		return LocationAreaDTO{}, err
-	}
+func (c *Client) GetEvolutionChain(ctx context.Context, id int) (EvolutionChainDTO, error) {
+	return fetch[EvolutionChainDTO](ctx, c, c.endpoint("evolution-chain", strconv.Itoa(id)))
+}
 
-	return locationArea, nil
+func (c *Client) GetType(ctx context.Context, typeNameOrID string) (TypeDTO, error) {
+	return fetch[TypeDTO](ctx, c, c.endpoint("type", typeNameOrID))
 }
 
-func GetLocationAreaDetails(areaOrID string) (LocationAreaDetailsDTO, error) {
-	locationUrl := baseURL + "/location-area/" + areaOrID
+func (c *Client) GetMove(ctx context.Context, moveNameOrID string) (MoveDTO, error) {
+	return fetch[MoveDTO](ctx, c, c.endpoint("move", moveNameOrID))
+}
 
-	var locationAreaDetails LocationAreaDetailsDTO
-	if cacheEntry, ok := cache.Get(locationUrl); ok {
-		err := json.Unmarshal(cacheEntry, &locationAreaDetails)
-		if err != nil {
-			return LocationAreaDetailsDTO{}, err
-		}
-		return locationAreaDetails, nil
-	}
+func (c *Client) GetAbility(ctx context.Context, abilityNameOrID string) (AbilityDTO, error) {
+	return fetch[AbilityDTO](ctx, c, c.endpoint("ability", abilityNameOrID))
+}
 
-	res, err := http.Get(locationUrl)
-	if err != nil {
-		return LocationAreaDetailsDTO{}, err
-	}
-	defer res.Body.Close()
+func (c *Client) GetItem(ctx context.Context, itemNameOrID string) (ItemDTO, error) {
+	return fetch[ItemDTO](ctx, c, c.endpoint("item", itemNameOrID))
+}
 
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return LocationAreaDetailsDTO{}, err
-	}
+func (c *Client) GetBerry(ctx context.Context, berryNameOrID string) (BerryDTO, error) {
+	return fetch[BerryDTO](ctx, c, c.endpoint("berry", berryNameOrID))
+}
 
-	cache.Add(locationUrl, data)
+func (c *Client) GetBerryFirmness(ctx context.Context, firmnessNameOrID string) (BerryFirmnessDTO, error) {
+	return fetch[BerryFirmnessDTO](ctx, c, c.endpoint("berry-firmness", firmnessNameOrID))
+}
 
-	err = json.Unmarshal(data, &locationAreaDetails)
-	if err != nil {
-		return LocationAreaDetailsDTO{}, err
-	}
+func (c *Client) GetBerryFlavor(ctx context.Context, flavorNameOrID string) (BerryFlavorDTO, error) {
+	return fetch[BerryFlavorDTO](ctx, c, c.endpoint("berry-flavor", flavorNameOrID))
+}
 
-	return locationAreaDetails, nil
+func (c *Client) GetRegion(ctx context.Context, regionNameOrID string) (RegionDTO, error) {
+	return fetch[RegionDTO](ctx, c, c.endpoint("region", regionNameOrID))
 }
 
-func GetPokemon(pokemonNameOrID string) (PokemonDTO, error) {
-	locationUrl := baseURL + "/pokemon/" + pokemonNameOrID
+func (c *Client) GetLocation(ctx context.Context, locationNameOrID string) (LocationDTO, error) {
+	return fetch[LocationDTO](ctx, c, c.endpoint("location", locationNameOrID))
+}
 
-	var pokemon PokemonDTO
-	if cacheEntry, ok := cache.Get(locationUrl); ok {
-		err := json.Unmarshal(cacheEntry, &pokemon)
-		if err != nil {
-			return PokemonDTO{}, err
-		}
-		return pokemon, nil
-	}
+func (c *Client) GetVersion(ctx context.Context, versionNameOrID string) (VersionDTO, error) {
+	return fetch[VersionDTO](ctx, c, c.endpoint("version", versionNameOrID))
+}
 
-	res, err := http.Get(locationUrl)
-	if err != nil {
-		return PokemonDTO{}, err
-	}
-	defer res.Body.Close()
+func (c *Client) GetVersionGroup(ctx context.Context, versionGroupNameOrID string) (VersionGroupDTO, error) {
+	return fetch[VersionGroupDTO](ctx, c, c.endpoint("version-group", versionGroupNameOrID))
+}
 
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return PokemonDTO{}, err
-	}
+func (c *Client) GetNature(ctx context.Context, natureNameOrID string) (NatureDTO, error) {
+	return fetch[NatureDTO](ctx, c, c.endpoint("nature", natureNameOrID))
+}
 
-	cache.Add(locationUrl, data)
+func (c *Client) GetStat(ctx context.Context, statNameOrID string) (StatDTO, error) {
+	return fetch[StatDTO](ctx, c, c.endpoint("stat", statNameOrID))
+}
 
-	err = json.Unmarshal(data, &pokemon)
-	if err != nil {
-		return PokemonDTO{}, err
-	}
+func (c *Client) GetCharacteristic(ctx context.Context, id int) (CharacteristicDTO, error) {
+	return fetch[CharacteristicDTO](ctx, c, c.endpoint("characteristic", strconv.Itoa(id)))
+}
+
+func (c *Client) GetEggGroup(ctx context.Context, eggGroupNameOrID string) (EggGroupDTO, error) {
+	return fetch[EggGroupDTO](ctx, c, c.endpoint("egg-group", eggGroupNameOrID))
+}
+
+func (c *Client) GetGrowthRate(ctx context.Context, growthRateNameOrID string) (GrowthRateDTO, error) {
+	return fetch[GrowthRateDTO](ctx, c, c.endpoint("growth-rate", growthRateNameOrID))
+}
+
+func (c *Client) GetGender(ctx context.Context, genderNameOrID string) (GenderDTO, error) {
+	return fetch[GenderDTO](ctx, c, c.endpoint("gender", genderNameOrID))
+}
+
+func (c *Client) GetMachine(ctx context.Context, id int) (MachineDTO, error) {
+	return fetch[MachineDTO](ctx, c, c.endpoint("machine", strconv.Itoa(id)))
+}
+
+func (c *Client) GetPokedex(ctx context.Context, pokedexNameOrID string) (PokedexDTO, error) {
+	return fetch[PokedexDTO](ctx, c, c.endpoint("pokedex", pokedexNameOrID))
+}
 
-	return pokemon, nil
+func (c *Client) GetPokemonEncounters(ctx context.Context, pokemonNameOrID string) ([]LocationAreaEncounterDTO, error) {
+	return fetch[[]LocationAreaEncounterDTO](ctx, c, c.endpoint("pokemon", pokemonNameOrID, "encounters"))
 }
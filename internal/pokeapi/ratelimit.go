@@ -0,0 +1,99 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRequestsPerSecond = 10
+	defaultBurst             = 20
+)
+
+// Stats reports how much the client's rate limiter has slowed it down.
+type Stats struct {
+	ThrottledRequests int
+	ThrottledTime     time.Duration
+}
+
+// WithRateLimit caps the client at requestsPerSecond, allowing bursts of up
+// to burst requests. A non-positive rate disables the limiter.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// rateLimiter is a token bucket shared by every request made through the
+// same client. A nil *rateLimiter never blocks.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  Stats
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	burst = max(burst, 1)
+
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done. Tokens are
+// reserved up front so concurrent callers queue up in order.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	err := sleep(ctx, delay)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stats.ThrottledRequests++
+	l.stats.ThrottledTime += time.Since(now)
+	if err != nil {
+		// Hand the reservation back so cancelled calls don't slow others down.
+		l.tokens++
+	}
+
+	return err
+}
+
+func (l *rateLimiter) snapshot() Stats {
+	if l == nil {
+		return Stats{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// Stats returns the rate limiting statistics accumulated by the client.
+func (c *Client) Stats() Stats {
+	return c.limiter.snapshot()
+}
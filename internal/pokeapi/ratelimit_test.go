@@ -0,0 +1,76 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	const requests = 5
+	const rate = 100
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRateLimit(rate, 1))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetPokemon(context.Background(), fmt.Sprint(i)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	minElapsed := (requests - 1) * time.Second / rate
+	if elapsed := time.Since(start); elapsed < minElapsed {
+		t.Errorf("expected requests to take at least %v, took %v", minElapsed, elapsed)
+	}
+
+	stats := client.Stats()
+	if stats.ThrottledRequests != requests-1 {
+		t.Errorf("expected %d throttled requests, got %d", requests-1, stats.ThrottledRequests)
+	}
+	if stats.ThrottledTime <= 0 {
+		t.Errorf("expected throttled time to be recorded, got %v", stats.ThrottledTime)
+	}
+}
+
+func TestRateLimitCancelled(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if limiter.tokens < -0.5 {
+		t.Errorf("expected cancelled reservation to be returned, tokens at %v", limiter.tokens)
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	client := NewClient(WithRateLimit(0, 0))
+	if client.limiter != nil {
+		t.Errorf("expected limiter to be disabled")
+	}
+	if stats := client.Stats(); stats != (Stats{}) {
+		t.Errorf("expected empty stats, got %+v", stats)
+	}
+}
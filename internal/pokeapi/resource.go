@@ -0,0 +1,60 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NamedAPIResource is a link to another PokeAPI resource. T is the DTO the
+// linked resource decodes into.
+type NamedAPIResource[T any] struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Resolve fetches the linked resource through the client's cache.
+func (r NamedAPIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return fetch[T](ctx, c, r.URL)
+}
+
+func (r NamedAPIResource[T]) ID() (int, error) {
+	return idFromURL(r.URL)
+}
+
+// APIResource is a link to a resource that has no name, such as an
+// evolution chain or a machine.
+type APIResource[T any] struct {
+	URL string `json:"url"`
+}
+
+// Resolve fetches the linked resource through the client's cache.
+func (r APIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return fetch[T](ctx, c, r.URL)
+}
+
+func (r APIResource[T]) ID() (int, error) {
+	return idFromURL(r.URL)
+}
+
+// ResourceDTO holds the fields every named resource shares. Links to
+// resources without a dedicated DTO (languages, generations, encounter
+// methods...) resolve to it.
+type ResourceDTO struct {
+	ID    int       `json:"id"`
+	Name  string    `json:"name"`
+	Names []NameDTO `json:"names"`
+}
+
+// idFromURL extracts the numeric ID from a resource URL such as
+// "https://pokeapi.co/api/v2/evolution-chain/1/".
+func idFromURL(rawURL string) (int, error) {
+	segments := strings.Split(strings.TrimRight(rawURL, "/"), "/")
+	id, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return 0, fmt.Errorf("no resource ID in %q", rawURL)
+	}
+
+	return id, nil
+}
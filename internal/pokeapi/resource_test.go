@@ -0,0 +1,61 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNamedAPIResourceResolve(t *testing.T) {
+	hits := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits[r.URL.Path]++
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			fmt.Fprintf(w, `{"name": "pikachu", "species": {"name": "pikachu", "url": "http://%s/pokemon-species/25/"}}`, r.Host)
+		case "/pokemon-species/25/":
+			fmt.Fprintf(w, `{"id": 25, "name": "pikachu", "evolution_chain": {"url": "http://%s/evolution-chain/10/"}}`, r.Host)
+		case "/evolution-chain/10/":
+			w.Write([]byte(`{"id": 10, "chain": {"species": {"name": "pichu"}, "evolves_to": [{"species": {"name": "pikachu"}}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(WithBaseURL(server.URL))
+
+	pokemon, err := client.GetPokemon(ctx, "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if id, err := pokemon.Species.ID(); err != nil || id != 25 {
+		t.Errorf("Species.ID() == %d, %v, expected 25", id, err)
+	}
+
+	for range 2 {
+		species, err := pokemon.Species.Resolve(ctx, client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if species.ID != 25 {
+			t.Errorf("expected species 25, got %d", species.ID)
+		}
+
+		evolutionChain, err := species.EvolutionChain.Resolve(ctx, client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if evolutionChain.Chain.Species.Name != "pichu" || evolutionChain.Chain.EvolvesTo[0].Species.Name != "pikachu" {
+			t.Errorf("unexpected evolution chain %+v", evolutionChain)
+		}
+	}
+
+	if hits["/pokemon-species/25/"] != 1 || hits["/evolution-chain/10/"] != 1 {
+		t.Errorf("expected resolved resources to be cached, got %v", hits)
+	}
+}
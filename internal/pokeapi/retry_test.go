@@ -0,0 +1,183 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:          3,
+	BaseDelay:            time.Millisecond,
+	MaxDelay:             5 * time.Millisecond,
+	RetryableStatusCodes: DefaultRetryPolicy.RetryableStatusCodes,
+}
+
+func TestRetry(t *testing.T) {
+	cases := []struct {
+		name         string
+		failures     []int
+		expectedErr  error
+		expectedHits int
+	}{
+		{
+			name:         "recovers from bad gateway",
+			failures:     []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			expectedHits: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			failures:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expectedErr:  &HTTPError{},
+			expectedHits: 3,
+		},
+		{
+			name:         "does not retry not found",
+			failures:     []int{http.StatusNotFound},
+			expectedErr:  ErrNotFound,
+			expectedHits: 1,
+		},
+		{
+			name:         "reports rate limiting",
+			failures:     []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			expectedErr:  ErrRateLimited,
+			expectedHits: 3,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			hits := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits++
+				if hits <= len(c.failures) {
+					w.WriteHeader(c.failures[hits-1])
+					return
+				}
+				w.Write([]byte(`{"name": "pikachu"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(testRetryPolicy))
+			_, err := client.GetPokemon(context.Background(), "pikachu")
+
+			switch expected := c.expectedErr.(type) {
+			case nil:
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			case *HTTPError:
+				if !errors.As(err, &expected) {
+					t.Errorf("expected *HTTPError, got %v", err)
+				}
+			default:
+				if !errors.Is(err, expected) {
+					t.Errorf("expected %v, got %v", expected, err)
+				}
+			}
+
+			if hits != c.expectedHits {
+				t.Errorf("expected %d requests, got %d", c.expectedHits, hits)
+			}
+		})
+	}
+}
+
+func TestRetryTransportError(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits == 1 {
+			// Drop the connection without writing a response.
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(testRetryPolicy))
+	if _, err := client.GetPokemon(context.Background(), "pikachu"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if hits != 2 {
+		t.Errorf("expected 2 requests, got %d", hits)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	const wait = time.Second
+	policy := testRetryPolicy
+	policy.MaxDelay = 2 * wait
+
+	var first time.Time
+	var second time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if first.IsZero() {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(policy))
+	if _, err := client.GetPokemon(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if elapsed := second.Sub(first); elapsed < wait {
+		t.Errorf("expected to wait at least %v before retrying, waited %v", wait, elapsed)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(DefaultRetryPolicy))
+	start := time.Now()
+	_, err := client.GetPokemon(context.Background(), "pikachu")
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected rate limited *HTTPError, got %v", err)
+	}
+	if hits != 1 {
+		t.Errorf("expected 1 request, got %d", hits)
+	}
+	if elapsed := time.Since(start); elapsed > DefaultRetryPolicy.MaxDelay {
+		t.Errorf("expected to give up without waiting, took %v", elapsed)
+	}
+}
+
+func TestRetryCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	policy := testRetryPolicy
+	policy.MaxDelay = 2 * time.Minute
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(policy))
+	_, err := client.GetPokemon(ctx, "pikachu")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
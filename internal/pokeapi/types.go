@@ -1,66 +1,52 @@
 package pokeapi
 
-type LocationAreaDTO struct {
-	Count    int     `json:"count"`
-	Next     *string `json:"next"`
-	Previous *string `json:"previous"`
-	Results  []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"results"`
-}
+import (
+	"strconv"
+	"strings"
+)
 
 type LocationAreaDetailsDTO struct {
 	EncounterMethodRates []struct {
-		EncounterMethod struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"encounter_method"`
-		VersionDetails []struct {
-			Rate    int `json:"rate"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
+		EncounterMethod NamedAPIResource[ResourceDTO] `json:"encounter_method"`
+		VersionDetails  []struct {
+			Rate    int                          `json:"rate"`
+			Version NamedAPIResource[VersionDTO] `json:"version"`
 		} `json:"version_details"`
 	} `json:"encounter_method_rates"`
-	GameIndex int `json:"game_index"`
-	ID        int `json:"id"`
-	Location  struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"location"`
-	Name  string `json:"name"`
-	Names []struct {
-		Language struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"language"`
-		Name string `json:"name"`
-	} `json:"names"`
-	PokemonEncounters []struct {
-		Pokemon struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			EncounterDetails []struct {
-				Chance          int   `json:"chance"`
-				ConditionValues []any `json:"condition_values"`
-				MaxLevel        int   `json:"max_level"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-				MinLevel int `json:"min_level"`
-			} `json:"encounter_details"`
-			MaxChance int `json:"max_chance"`
-			Version   struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-		} `json:"version_details"`
-	} `json:"pokemon_encounters"`
+	GameIndex         int                           `json:"game_index"`
+	ID                int                           `json:"id"`
+	Location          NamedAPIResource[LocationDTO] `json:"location"`
+	Name              string                        `json:"name"`
+	Names             []NameDTO                     `json:"names"`
+	PokemonEncounters []PokemonEncounterDTO         `json:"pokemon_encounters"`
+}
+
+type PokemonEncounterDTO struct {
+	Pokemon        NamedAPIResource[PokemonDTO] `json:"pokemon"`
+	VersionDetails []struct {
+		EncounterDetails []EncounterDTO               `json:"encounter_details"`
+		MaxChance        int                          `json:"max_chance"`
+		Version          NamedAPIResource[VersionDTO] `json:"version"`
+	} `json:"version_details"`
+}
+
+// InVersion reports whether the Pokemon can be encountered in the named
+// version.
+func (e PokemonEncounterDTO) InVersion(version string) bool {
+	for _, details := range e.VersionDetails {
+		if details.Version.Name == version {
+			return true
+		}
+	}
+	return false
+}
+
+type EncounterDTO struct {
+	Chance          int                             `json:"chance"`
+	ConditionValues []NamedAPIResource[ResourceDTO] `json:"condition_values"`
+	MaxLevel        int                             `json:"max_level"`
+	MinLevel        int                             `json:"min_level"`
+	Method          NamedAPIResource[ResourceDTO]   `json:"method"`
 }
 
 type PokemonDTO struct {
@@ -72,59 +58,32 @@ type PokemonDTO struct {
 	Order          int    `json:"order"`
 	Weight         int    `json:"weight"`
 	Abilities      []struct {
-		IsHidden bool `json:"is_hidden"`
-		Slot     int  `json:"slot"`
-		Ability  struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"ability"`
+		IsHidden bool                         `json:"is_hidden"`
+		Slot     int                          `json:"slot"`
+		Ability  NamedAPIResource[AbilityDTO] `json:"ability"`
 	} `json:"abilities"`
-	Forms []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"forms"`
+	Forms       []NamedAPIResource[ResourceDTO] `json:"forms"`
 	GameIndices []struct {
-		GameIndex int `json:"game_index"`
-		Version   struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"version"`
+		GameIndex int                          `json:"game_index"`
+		Version   NamedAPIResource[VersionDTO] `json:"version"`
 	} `json:"game_indices"`
 	HeldItems []struct {
-		Item struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"item"`
+		Item           NamedAPIResource[ItemDTO] `json:"item"`
 		VersionDetails []struct {
-			Rarity  int `json:"rarity"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
+			Rarity  int                          `json:"rarity"`
+			Version NamedAPIResource[VersionDTO] `json:"version"`
 		} `json:"version_details"`
 	} `json:"held_items"`
 	LocationAreaEncounters string `json:"location_area_encounters"`
 	Moves                  []struct {
-		Move struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"move"`
+		Move                NamedAPIResource[MoveDTO] `json:"move"`
 		VersionGroupDetails []struct {
-			LevelLearnedAt int `json:"level_learned_at"`
-			VersionGroup   struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version_group"`
-			MoveLearnMethod struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"move_learn_method"`
+			LevelLearnedAt  int                               `json:"level_learned_at"`
+			VersionGroup    NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+			MoveLearnMethod NamedAPIResource[ResourceDTO]     `json:"move_learn_method"`
 		} `json:"version_group_details"`
 	} `json:"moves"`
-	Species struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"species"`
+	Species NamedAPIResource[PokemonSpeciesDTO] `json:"species"`
 	Sprites struct {
 		BackDefault      string `json:"back_default"`
 		BackFemale       any    `json:"back_female"`
@@ -306,31 +265,476 @@ type PokemonDTO struct {
 		Legacy string `json:"legacy"`
 	} `json:"cries"`
 	Stats []struct {
-		BaseStat int `json:"base_stat"`
-		Effort   int `json:"effort"`
-		Stat     struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"stat"`
+		BaseStat int                       `json:"base_stat"`
+		Effort   int                       `json:"effort"`
+		Stat     NamedAPIResource[StatDTO] `json:"stat"`
 	} `json:"stats"`
 	Types []struct {
-		Slot int `json:"slot"`
-		Type struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"type"`
+		Slot int                       `json:"slot"`
+		Type NamedAPIResource[TypeDTO] `json:"type"`
 	} `json:"types"`
 	PastTypes []struct {
-		Generation struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"generation"`
-		Types []struct {
-			Slot int `json:"slot"`
-			Type struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"type"`
+		Generation NamedAPIResource[ResourceDTO] `json:"generation"`
+		Types      []struct {
+			Slot int                       `json:"slot"`
+			Type NamedAPIResource[TypeDTO] `json:"type"`
 		} `json:"types"`
 	} `json:"past_types"`
 }
+
+type PokemonSpeciesDTO struct {
+	ID                   int                             `json:"id"`
+	Name                 string                          `json:"name"`
+	Order                int                             `json:"order"`
+	GenderRate           int                             `json:"gender_rate"`
+	CaptureRate          int                             `json:"capture_rate"`
+	BaseHappiness        int                             `json:"base_happiness"`
+	IsBaby               bool                            `json:"is_baby"`
+	IsLegendary          bool                            `json:"is_legendary"`
+	IsMythical           bool                            `json:"is_mythical"`
+	HatchCounter         int                             `json:"hatch_counter"`
+	HasGenderDifferences bool                            `json:"has_gender_differences"`
+	FormsSwitchable      bool                            `json:"forms_switchable"`
+	GrowthRate           NamedAPIResource[GrowthRateDTO] `json:"growth_rate"`
+	PokedexNumbers       []struct {
+		EntryNumber int                          `json:"entry_number"`
+		Pokedex     NamedAPIResource[PokedexDTO] `json:"pokedex"`
+	} `json:"pokedex_numbers"`
+	EggGroups          []NamedAPIResource[EggGroupDTO]      `json:"egg_groups"`
+	Color              NamedAPIResource[ResourceDTO]        `json:"color"`
+	Shape              NamedAPIResource[ResourceDTO]        `json:"shape"`
+	EvolvesFromSpecies *NamedAPIResource[PokemonSpeciesDTO] `json:"evolves_from_species"`
+	EvolutionChain     APIResource[EvolutionChainDTO]       `json:"evolution_chain"`
+	Habitat            *NamedAPIResource[ResourceDTO]       `json:"habitat"`
+	Generation         NamedAPIResource[ResourceDTO]        `json:"generation"`
+	Names              []NameDTO                            `json:"names"`
+	FlavorTextEntries  []FlavorTextDTO                      `json:"flavor_text_entries"`
+	Genera             []GenusDTO                           `json:"genera"`
+	Varieties          []struct {
+		IsDefault bool                         `json:"is_default"`
+		Pokemon   NamedAPIResource[PokemonDTO] `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// Genus returns the species' genus (e.g. "Seed Pokémon") in the given
+// language, or an empty string if there is none.
+func (s PokemonSpeciesDTO) Genus(language string) string {
+	genus, _ := inLanguage(s.Genera, language)
+	return genus.Genus
+}
+
+// FlavorText returns the most recent Pokedex entry in the given language
+// with its line breaks collapsed, or an empty string if there is none.
+func (s PokemonSpeciesDTO) FlavorText(language string) string {
+	return latestFlavorText(s.FlavorTextEntries, language)
+}
+
+type EvolutionChainDTO struct {
+	ID              int                        `json:"id"`
+	BabyTriggerItem *NamedAPIResource[ItemDTO] `json:"baby_trigger_item"`
+	Chain           ChainLinkDTO               `json:"chain"`
+}
+
+type ChainLinkDTO struct {
+	IsBaby           bool                                `json:"is_baby"`
+	Species          NamedAPIResource[PokemonSpeciesDTO] `json:"species"`
+	EvolutionDetails []EvolutionDetailDTO                `json:"evolution_details"`
+	EvolvesTo        []ChainLinkDTO                      `json:"evolves_to"`
+}
+
+type EvolutionDetailDTO struct {
+	Item                  *NamedAPIResource[ItemDTO]           `json:"item"`
+	Trigger               NamedAPIResource[ResourceDTO]        `json:"trigger"`
+	Gender                *int                                 `json:"gender"`
+	HeldItem              *NamedAPIResource[ItemDTO]           `json:"held_item"`
+	KnownMove             *NamedAPIResource[MoveDTO]           `json:"known_move"`
+	KnownMoveType         *NamedAPIResource[TypeDTO]           `json:"known_move_type"`
+	Location              *NamedAPIResource[LocationDTO]       `json:"location"`
+	MinLevel              *int                                 `json:"min_level"`
+	MinHappiness          *int                                 `json:"min_happiness"`
+	MinBeauty             *int                                 `json:"min_beauty"`
+	MinAffection          *int                                 `json:"min_affection"`
+	NeedsOverworldRain    bool                                 `json:"needs_overworld_rain"`
+	PartySpecies          *NamedAPIResource[PokemonSpeciesDTO] `json:"party_species"`
+	PartyType             *NamedAPIResource[TypeDTO]           `json:"party_type"`
+	RelativePhysicalStats *int                                 `json:"relative_physical_stats"`
+	TimeOfDay             string                               `json:"time_of_day"`
+	TradeSpecies          *NamedAPIResource[PokemonSpeciesDTO] `json:"trade_species"`
+	TurnUpsideDown        bool                                 `json:"turn_upside_down"`
+}
+
+type TypeDTO struct {
+	ID                  int              `json:"id"`
+	Name                string           `json:"name"`
+	DamageRelations     TypeRelationsDTO `json:"damage_relations"`
+	PastDamageRelations []struct {
+		Generation      NamedAPIResource[ResourceDTO] `json:"generation"`
+		DamageRelations TypeRelationsDTO              `json:"damage_relations"`
+	} `json:"past_damage_relations"`
+	GameIndices []struct {
+		GameIndex  int                           `json:"game_index"`
+		Generation NamedAPIResource[ResourceDTO] `json:"generation"`
+	} `json:"game_indices"`
+	Generation      NamedAPIResource[ResourceDTO]  `json:"generation"`
+	MoveDamageClass *NamedAPIResource[ResourceDTO] `json:"move_damage_class"`
+	Names           []NameDTO                      `json:"names"`
+	Pokemon         []struct {
+		Slot    int                          `json:"slot"`
+		Pokemon NamedAPIResource[PokemonDTO] `json:"pokemon"`
+	} `json:"pokemon"`
+	Moves []NamedAPIResource[MoveDTO] `json:"moves"`
+}
+
+type TypeRelationsDTO struct {
+	NoDamageTo       []NamedAPIResource[TypeDTO] `json:"no_damage_to"`
+	HalfDamageTo     []NamedAPIResource[TypeDTO] `json:"half_damage_to"`
+	DoubleDamageTo   []NamedAPIResource[TypeDTO] `json:"double_damage_to"`
+	NoDamageFrom     []NamedAPIResource[TypeDTO] `json:"no_damage_from"`
+	HalfDamageFrom   []NamedAPIResource[TypeDTO] `json:"half_damage_from"`
+	DoubleDamageFrom []NamedAPIResource[TypeDTO] `json:"double_damage_from"`
+}
+
+type MoveDTO struct {
+	ID                int                            `json:"id"`
+	Name              string                         `json:"name"`
+	Accuracy          *int                           `json:"accuracy"`
+	EffectChance      *int                           `json:"effect_chance"`
+	PP                *int                           `json:"pp"`
+	Priority          int                            `json:"priority"`
+	Power             *int                           `json:"power"`
+	DamageClass       NamedAPIResource[ResourceDTO]  `json:"damage_class"`
+	EffectEntries     []VerboseEffectDTO             `json:"effect_entries"`
+	FlavorTextEntries []FlavorTextDTO                `json:"flavor_text_entries"`
+	Generation        NamedAPIResource[ResourceDTO]  `json:"generation"`
+	LearnedByPokemon  []NamedAPIResource[PokemonDTO] `json:"learned_by_pokemon"`
+	Machines          []struct {
+		Machine      APIResource[MachineDTO]           `json:"machine"`
+		VersionGroup NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+	} `json:"machines"`
+	Target NamedAPIResource[ResourceDTO] `json:"target"`
+	Type   NamedAPIResource[TypeDTO]     `json:"type"`
+}
+
+// ShortEffect returns the move's short effect text in the given language,
+// with the effect chance filled in, or an empty string if there is none.
+func (m MoveDTO) ShortEffect(language string) string {
+	entry, ok := inLanguage(m.EffectEntries, language)
+	if ok && m.EffectChance != nil {
+		return strings.ReplaceAll(entry.ShortEffect, "$effect_chance", strconv.Itoa(*m.EffectChance))
+	}
+	return entry.ShortEffect
+}
+
+type AbilityDTO struct {
+	ID                int                           `json:"id"`
+	Name              string                        `json:"name"`
+	IsMainSeries      bool                          `json:"is_main_series"`
+	Generation        NamedAPIResource[ResourceDTO] `json:"generation"`
+	Names             []NameDTO                     `json:"names"`
+	EffectEntries     []VerboseEffectDTO            `json:"effect_entries"`
+	FlavorTextEntries []FlavorTextDTO               `json:"flavor_text_entries"`
+	Pokemon           []struct {
+		IsHidden bool                         `json:"is_hidden"`
+		Slot     int                          `json:"slot"`
+		Pokemon  NamedAPIResource[PokemonDTO] `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// ShortEffect returns the ability's short effect text in the given
+// language, falling back to the latest flavor text for abilities that have
+// no effect entries.
+func (a AbilityDTO) ShortEffect(language string) string {
+	if entry, ok := inLanguage(a.EffectEntries, language); ok {
+		return entry.ShortEffect
+	}
+	return latestFlavorText(a.FlavorTextEntries, language)
+}
+
+type ItemDTO struct {
+	ID                int                             `json:"id"`
+	Name              string                          `json:"name"`
+	Cost              int                             `json:"cost"`
+	FlingPower        *int                            `json:"fling_power"`
+	FlingEffect       *NamedAPIResource[ResourceDTO]  `json:"fling_effect"`
+	Attributes        []NamedAPIResource[ResourceDTO] `json:"attributes"`
+	Category          NamedAPIResource[ResourceDTO]   `json:"category"`
+	EffectEntries     []VerboseEffectDTO              `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		Text         string                            `json:"text"`
+		VersionGroup NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+		Language     NamedAPIResource[ResourceDTO]     `json:"language"`
+	} `json:"flavor_text_entries"`
+	GameIndices []struct {
+		GameIndex  int                           `json:"game_index"`
+		Generation NamedAPIResource[ResourceDTO] `json:"generation"`
+	} `json:"game_indices"`
+	Names   []NameDTO `json:"names"`
+	Sprites struct {
+		Default string `json:"default"`
+	} `json:"sprites"`
+	HeldByPokemon []struct {
+		Pokemon        NamedAPIResource[PokemonDTO] `json:"pokemon"`
+		VersionDetails []struct {
+			Rarity  int                          `json:"rarity"`
+			Version NamedAPIResource[VersionDTO] `json:"version"`
+		} `json:"version_details"`
+	} `json:"held_by_pokemon"`
+	Machines []struct {
+		Machine      APIResource[MachineDTO]           `json:"machine"`
+		VersionGroup NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+	} `json:"machines"`
+	BabyTriggerFor *APIResource[EvolutionChainDTO] `json:"baby_trigger_for"`
+}
+
+// ShortEffect returns the item's short effect text in the given language,
+// or an empty string if there is none.
+func (i ItemDTO) ShortEffect(language string) string {
+	entry, _ := inLanguage(i.EffectEntries, language)
+	return entry.ShortEffect
+}
+
+// HasAttribute reports whether the item has the named attribute, such as
+// "holdable" or "usable-overworld".
+func (i ItemDTO) HasAttribute(name string) bool {
+	for _, attribute := range i.Attributes {
+		if attribute.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type BerryDTO struct {
+	ID               int                                `json:"id"`
+	Name             string                             `json:"name"`
+	GrowthTime       int                                `json:"growth_time"`
+	MaxHarvest       int                                `json:"max_harvest"`
+	NaturalGiftPower int                                `json:"natural_gift_power"`
+	Size             int                                `json:"size"`
+	Smoothness       int                                `json:"smoothness"`
+	SoilDryness      int                                `json:"soil_dryness"`
+	Firmness         NamedAPIResource[BerryFirmnessDTO] `json:"firmness"`
+	Flavors          []struct {
+		Potency int                              `json:"potency"`
+		Flavor  NamedAPIResource[BerryFlavorDTO] `json:"flavor"`
+	} `json:"flavors"`
+	Item            NamedAPIResource[ItemDTO] `json:"item"`
+	NaturalGiftType NamedAPIResource[TypeDTO] `json:"natural_gift_type"`
+}
+
+type BerryFirmnessDTO struct {
+	ID      int                          `json:"id"`
+	Name    string                       `json:"name"`
+	Berries []NamedAPIResource[BerryDTO] `json:"berries"`
+	Names   []NameDTO                    `json:"names"`
+}
+
+// LocalizedName returns the firmness name in the given language, falling
+// back to its API name.
+func (f BerryFirmnessDTO) LocalizedName(language string) string {
+	return localizedName(f.Names, language, f.Name)
+}
+
+type BerryFlavorDTO struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Berries []struct {
+		Potency int                        `json:"potency"`
+		Berry   NamedAPIResource[BerryDTO] `json:"berry"`
+	} `json:"berries"`
+	ContestType NamedAPIResource[ResourceDTO] `json:"contest_type"`
+	Names       []NameDTO                     `json:"names"`
+}
+
+type RegionDTO struct {
+	ID             int                                 `json:"id"`
+	Name           string                              `json:"name"`
+	Locations      []NamedAPIResource[LocationDTO]     `json:"locations"`
+	MainGeneration *NamedAPIResource[ResourceDTO]      `json:"main_generation"`
+	Names          []NameDTO                           `json:"names"`
+	Pokedexes      []NamedAPIResource[PokedexDTO]      `json:"pokedexes"`
+	VersionGroups  []NamedAPIResource[VersionGroupDTO] `json:"version_groups"`
+}
+
+type LocationDTO struct {
+	ID          int                          `json:"id"`
+	Name        string                       `json:"name"`
+	Region      *NamedAPIResource[RegionDTO] `json:"region"`
+	Names       []NameDTO                    `json:"names"`
+	GameIndices []struct {
+		GameIndex  int                           `json:"game_index"`
+		Generation NamedAPIResource[ResourceDTO] `json:"generation"`
+	} `json:"game_indices"`
+	Areas []NamedAPIResource[LocationAreaDetailsDTO] `json:"areas"`
+}
+
+type VersionDTO struct {
+	ID           int                               `json:"id"`
+	Name         string                            `json:"name"`
+	Names        []NameDTO                         `json:"names"`
+	VersionGroup NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+}
+
+type VersionGroupDTO struct {
+	ID               int                             `json:"id"`
+	Name             string                          `json:"name"`
+	Order            int                             `json:"order"`
+	Generation       NamedAPIResource[ResourceDTO]   `json:"generation"`
+	MoveLearnMethods []NamedAPIResource[ResourceDTO] `json:"move_learn_methods"`
+	Pokedexes        []NamedAPIResource[PokedexDTO]  `json:"pokedexes"`
+	Regions          []NamedAPIResource[RegionDTO]   `json:"regions"`
+	Versions         []NamedAPIResource[VersionDTO]  `json:"versions"`
+}
+
+type NatureDTO struct {
+	ID                    int                               `json:"id"`
+	Name                  string                            `json:"name"`
+	DecreasedStat         *NamedAPIResource[StatDTO]        `json:"decreased_stat"`
+	IncreasedStat         *NamedAPIResource[StatDTO]        `json:"increased_stat"`
+	HatesFlavor           *NamedAPIResource[BerryFlavorDTO] `json:"hates_flavor"`
+	LikesFlavor           *NamedAPIResource[BerryFlavorDTO] `json:"likes_flavor"`
+	PokeathlonStatChanges []struct {
+		MaxChange      int                           `json:"max_change"`
+		PokeathlonStat NamedAPIResource[ResourceDTO] `json:"pokeathlon_stat"`
+	} `json:"pokeathlon_stat_changes"`
+	MoveBattleStylePreferences []struct {
+		LowHPPreference  int                           `json:"low_hp_preference"`
+		HighHPPreference int                           `json:"high_hp_preference"`
+		MoveBattleStyle  NamedAPIResource[ResourceDTO] `json:"move_battle_style"`
+	} `json:"move_battle_style_preferences"`
+	Names []NameDTO `json:"names"`
+}
+
+type StatDTO struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	GameIndex      int    `json:"game_index"`
+	IsBattleOnly   bool   `json:"is_battle_only"`
+	AffectingMoves struct {
+		Increase []struct {
+			Change int                       `json:"change"`
+			Move   NamedAPIResource[MoveDTO] `json:"move"`
+		} `json:"increase"`
+		Decrease []struct {
+			Change int                       `json:"change"`
+			Move   NamedAPIResource[MoveDTO] `json:"move"`
+		} `json:"decrease"`
+	} `json:"affecting_moves"`
+	AffectingNatures struct {
+		Increase []NamedAPIResource[NatureDTO] `json:"increase"`
+		Decrease []NamedAPIResource[NatureDTO] `json:"decrease"`
+	} `json:"affecting_natures"`
+	Characteristics []APIResource[CharacteristicDTO] `json:"characteristics"`
+	MoveDamageClass *NamedAPIResource[ResourceDTO]   `json:"move_damage_class"`
+	Names           []NameDTO                        `json:"names"`
+}
+
+type CharacteristicDTO struct {
+	ID             int                       `json:"id"`
+	GeneModulo     int                       `json:"gene_modulo"`
+	PossibleValues []int                     `json:"possible_values"`
+	HighestStat    NamedAPIResource[StatDTO] `json:"highest_stat"`
+	Descriptions   []DescriptionDTO          `json:"descriptions"`
+}
+
+// Description returns the characteristic's text in the given language, or
+// an empty string if there is none.
+func (c CharacteristicDTO) Description(language string) string {
+	description, _ := inLanguage(c.Descriptions, language)
+	return description.Description
+}
+
+type EggGroupDTO struct {
+	ID             int                                   `json:"id"`
+	Name           string                                `json:"name"`
+	Names          []NameDTO                             `json:"names"`
+	PokemonSpecies []NamedAPIResource[PokemonSpeciesDTO] `json:"pokemon_species"`
+}
+
+// LocalizedName returns the egg group name in the given language, falling
+// back to its API name.
+func (e EggGroupDTO) LocalizedName(language string) string {
+	return localizedName(e.Names, language, e.Name)
+}
+
+type GrowthRateDTO struct {
+	ID           int              `json:"id"`
+	Name         string           `json:"name"`
+	Formula      string           `json:"formula"`
+	Descriptions []DescriptionDTO `json:"descriptions"`
+	Levels       []struct {
+		Level      int `json:"level"`
+		Experience int `json:"experience"`
+	} `json:"levels"`
+	PokemonSpecies []NamedAPIResource[PokemonSpeciesDTO] `json:"pokemon_species"`
+}
+
+// ExperienceForLevel returns the total experience needed to reach level.
+func (g GrowthRateDTO) ExperienceForLevel(level int) (int, bool) {
+	for _, l := range g.Levels {
+		if l.Level == level {
+			return l.Experience, true
+		}
+	}
+	return 0, false
+}
+
+// LevelForExperience returns the level reached with the given total
+// experience.
+func (g GrowthRateDTO) LevelForExperience(experience int) int {
+	level := 1
+	for _, l := range g.Levels {
+		if l.Experience <= experience && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
+
+type GenderDTO struct {
+	ID                    int    `json:"id"`
+	Name                  string `json:"name"`
+	PokemonSpeciesDetails []struct {
+		Rate           int                                 `json:"rate"`
+		PokemonSpecies NamedAPIResource[PokemonSpeciesDTO] `json:"pokemon_species"`
+	} `json:"pokemon_species_details"`
+	RequiredForEvolution []NamedAPIResource[PokemonSpeciesDTO] `json:"required_for_evolution"`
+}
+
+type MachineDTO struct {
+	ID           int                               `json:"id"`
+	Item         NamedAPIResource[ItemDTO]         `json:"item"`
+	Move         NamedAPIResource[MoveDTO]         `json:"move"`
+	VersionGroup NamedAPIResource[VersionGroupDTO] `json:"version_group"`
+}
+
+type PokedexDTO struct {
+	ID             int                                 `json:"id"`
+	Name           string                              `json:"name"`
+	IsMainSeries   bool                                `json:"is_main_series"`
+	Descriptions   []DescriptionDTO                    `json:"descriptions"`
+	Names          []NameDTO                           `json:"names"`
+	PokemonEntries []PokemonEntryDTO                   `json:"pokemon_entries"`
+	Region         *NamedAPIResource[RegionDTO]        `json:"region"`
+	VersionGroups  []NamedAPIResource[VersionGroupDTO] `json:"version_groups"`
+}
+
+type PokemonEntryDTO struct {
+	EntryNumber    int                                 `json:"entry_number"`
+	PokemonSpecies NamedAPIResource[PokemonSpeciesDTO] `json:"pokemon_species"`
+}
+
+// LocalizedName returns the Pokedex name in the given language, falling
+// back to its API name.
+func (p PokedexDTO) LocalizedName(language string) string {
+	return localizedName(p.Names, language, p.Name)
+}
+
+type LocationAreaEncounterDTO struct {
+	LocationArea   NamedAPIResource[LocationAreaDetailsDTO] `json:"location_area"`
+	VersionDetails []struct {
+		MaxChance        int                          `json:"max_chance"`
+		Version          NamedAPIResource[VersionDTO] `json:"version"`
+		EncounterDetails []EncounterDTO               `json:"encounter_details"`
+	} `json:"version_details"`
+}
@@ -0,0 +1,158 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGrowthRateLevels(t *testing.T) {
+	var growthRate GrowthRateDTO
+	err := json.Unmarshal([]byte(`{
+		"name": "medium",
+		"levels": [
+			{"level": 1, "experience": 0},
+			{"level": 2, "experience": 8},
+			{"level": 3, "experience": 27},
+			{"level": 4, "experience": 64}
+		]
+	}`), &growthRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if experience, ok := growthRate.ExperienceForLevel(3); !ok || experience != 27 {
+		t.Errorf("ExperienceForLevel(3) == %d, %v, expected 27, true", experience, ok)
+	}
+	if _, ok := growthRate.ExperienceForLevel(101); ok {
+		t.Errorf("ExperienceForLevel(101) expected not to be found")
+	}
+
+	cases := []struct {
+		experience int
+		expected   int
+	}{
+		{experience: 0, expected: 1},
+		{experience: 26, expected: 2},
+		{experience: 27, expected: 3},
+		{experience: 1000, expected: 4},
+	}
+	for _, c := range cases {
+		if actual := growthRate.LevelForExperience(c.experience); actual != c.expected {
+			t.Errorf("LevelForExperience(%d) == %d, expected %d", c.experience, actual, c.expected)
+		}
+	}
+}
+
+func TestPokemonSpeciesText(t *testing.T) {
+	var species PokemonSpeciesDTO
+	err := json.Unmarshal([]byte(`{
+		"name": "bulbasaur",
+		"genera": [
+			{"genus": "たねポケモン", "language": {"name": "ja"}},
+			{"genus": "Seed Pokémon", "language": {"name": "en"}}
+		],
+		"flavor_text_entries": [
+			{"flavor_text": "A strange seed was\nplanted on its\nback at birth.\fThe plant sprouts\nand grows with\nthis POKéMON.", "language": {"name": "en"}},
+			{"flavor_text": "Dès la naissance, une graine\nest plantée sur son dos.", "language": {"name": "fr"}},
+			{"flavor_text": "It can go for days\nwithout eating a\u00adsingle morsel.\f  In the bulb on\nits back, it stores energy.", "language": {"name": "en"}},
+			{"flavor_text": "Bisasam macht gern\nein Nickerchen.", "language": {"name": "de"}}
+		]
+	}`), &species)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		language   string
+		genus      string
+		flavorText string
+	}{
+		{language: "en", genus: "Seed Pokémon", flavorText: "It can go for days without eating a\u00adsingle morsel. In the bulb on its back, it stores energy."},
+		{language: "ja", genus: "たねポケモン", flavorText: ""},
+		{language: "fr", genus: "", flavorText: "Dès la naissance, une graine est plantée sur son dos."},
+		{language: "ko", genus: "", flavorText: ""},
+	}
+
+	for _, c := range cases {
+		if genus := species.Genus(c.language); genus != c.genus {
+			t.Errorf("Genus(%s) == %q, expected %q", c.language, genus, c.genus)
+		}
+		if flavorText := species.FlavorText(c.language); flavorText != c.flavorText {
+			t.Errorf("FlavorText(%s) == %q, expected %q", c.language, flavorText, c.flavorText)
+		}
+	}
+}
+
+func TestAbilityShortEffect(t *testing.T) {
+	cases := []struct {
+		name     string
+		ability  string
+		expected string
+	}{
+		{
+			name: "prefers effect entries",
+			ability: `{
+				"effect_entries": [
+					{"short_effect": "Kann bei Berührung paralysieren.", "language": {"name": "de"}},
+					{"short_effect": "Has a 30% chance of paralyzing attacking Pokémon on contact.", "language": {"name": "en"}}
+				],
+				"flavor_text_entries": [{"flavor_text": "May cause paralysis\nif touched.", "language": {"name": "en"}}]
+			}`,
+			expected: "Has a 30% chance of paralyzing attacking Pokémon on contact.",
+		},
+		{
+			name: "falls back to latest flavor text",
+			ability: `{
+				"effect_entries": [{"short_effect": "Kann bei Berührung paralysieren.", "language": {"name": "de"}}],
+				"flavor_text_entries": [
+					{"flavor_text": "May cause paralysis\nif touched.", "language": {"name": "en"}},
+					{"flavor_text": "Contact with the Pokémon\nmay cause paralysis.", "language": {"name": "en"}},
+					{"flavor_text": "Peut paralyser\nau contact.", "language": {"name": "fr"}}
+				]
+			}`,
+			expected: "Contact with the Pokémon may cause paralysis.",
+		},
+		{
+			name:     "no text in language",
+			ability:  `{"effect_entries": [{"short_effect": "Kann bei Berührung paralysieren.", "language": {"name": "de"}}]}`,
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var ability AbilityDTO
+			if err := json.Unmarshal([]byte(c.ability), &ability); err != nil {
+				t.Fatal(err)
+			}
+			if actual := ability.ShortEffect("en"); actual != c.expected {
+				t.Errorf("ShortEffect(en) == %q, expected %q", actual, c.expected)
+			}
+		})
+	}
+}
+
+func TestLocalizedName(t *testing.T) {
+	var pokedex PokedexDTO
+	err := json.Unmarshal([]byte(`{
+		"name": "kanto",
+		"names": [
+			{"name": "Kanto", "language": {"name": "en"}},
+			{"name": "カントー", "language": {"name": "ja"}}
+		]
+	}`), &pokedex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"en": "Kanto",
+		"ja": "カントー",
+		"ko": "kanto",
+	}
+	for language, expected := range cases {
+		if actual := pokedex.LocalizedName(language); actual != expected {
+			t.Errorf("LocalizedName(%s) == %q, expected %q", language, actual, expected)
+		}
+	}
+}
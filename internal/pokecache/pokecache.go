@@ -5,9 +5,26 @@ import (
 	"time"
 )
 
+// staleIntervals is how many intervals an expired entry with validators is
+// kept around for revalidation before it is dropped.
+const staleIntervals = 6
+
+// Validators identify a cached response so it can be revalidated with a
+// conditional request once it expires.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
 type cacheEntry struct {
-	createAt time.Time
-	val      []byte
+	createAt   time.Time
+	val        []byte
+	validators Validators
+	stale      bool
 }
 
 type Cache struct {
@@ -26,37 +43,89 @@ func NewCache(interval time.Duration) *Cache {
 }
 
 func (cache *Cache) Add(key string, val []byte) {
+	cache.AddWithValidators(key, val, Validators{})
+}
+
+// AddWithValidators stores val along with the validators of the response
+// it came from. Such entries are kept as stale for a while instead of being
+// dropped as soon as they expire.
+func (cache *Cache) AddWithValidators(key string, val []byte, validators Validators) {
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
 	cache.data[key] = cacheEntry{
-		createAt: time.Now(),
-		val:      val,
+		createAt:   time.Now(),
+		val:        val,
+		validators: validators,
 	}
 }
 
+// Get returns the value stored under key if it hasn't expired.
 func (cache *Cache) Get(key string) ([]byte, bool) {
 	cache.mu.RLock()
 	defer cache.mu.RUnlock()
 	entry, ok := cache.data[key]
-	if !ok {
+	if !ok || entry.stale {
 		return nil, false
 	}
 
 	return entry.val, true
 }
 
+// Stale returns an expired entry and its validators so the caller can ask
+// the server whether it is still current.
+func (cache *Cache) Stale(key string) ([]byte, Validators, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	entry, ok := cache.data[key]
+	if !ok || !entry.stale {
+		return nil, Validators{}, false
+	}
+
+	return entry.val, entry.validators, true
+}
+
+// Refresh marks the entry under key as fresh again, e.g. after the server
+// answered 304 Not Modified, replacing any validators the server sent along.
+// It reports whether the entry exists.
+func (cache *Cache) Refresh(key string, validators Validators) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	entry, ok := cache.data[key]
+	if !ok {
+		return false
+	}
+
+	if validators.ETag != "" {
+		entry.validators.ETag = validators.ETag
+	}
+	if validators.LastModified != "" {
+		entry.validators.LastModified = validators.LastModified
+	}
+	entry.createAt = time.Now()
+	entry.stale = false
+	cache.data[key] = entry
+	return true
+}
+
 func (cache *Cache) ReadLoop(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 
 	go func() {
 		for range ticker.C {
+			cache.mu.Lock()
 			for key, entry := range cache.data {
-				cache.mu.Lock()
-				if time.Since(entry.createAt) > interval {
+				age := time.Since(entry.createAt)
+				if age <= interval {
+					continue
+				}
+				if entry.validators.IsZero() || age > staleIntervals*interval {
 					delete(cache.data, key)
+					continue
 				}
-				cache.mu.Unlock()
+				entry.stale = true
+				cache.data[key] = entry
 			}
+			cache.mu.Unlock()
 		}
 	}()
 }
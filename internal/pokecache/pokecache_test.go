@@ -59,3 +59,55 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestStaleEntries(t *testing.T) {
+	const baseTime = 5 * time.Millisecond
+	const waitTime = baseTime + 5*time.Millisecond
+	cache := NewCache(baseTime)
+	validators := Validators{ETag: `"abc"`}
+	cache.AddWithValidators("https://example.com", []byte("testdata"), validators)
+
+	if _, _, ok := cache.Stale("https://example.com"); ok {
+		t.Errorf("expected fresh entry not to be stale")
+	}
+
+	time.Sleep(waitTime)
+
+	if _, ok := cache.Get("https://example.com"); ok {
+		t.Errorf("expected expired entry not to be returned by Get")
+	}
+	val, got, ok := cache.Stale("https://example.com")
+	if !ok || string(val) != "testdata" || got != validators {
+		t.Errorf("expected stale entry to be kept, got %q, %+v, %v", val, got, ok)
+	}
+
+	if !cache.Refresh("https://example.com", Validators{ETag: `"def"`}) {
+		t.Fatalf("expected to refresh entry")
+	}
+	if val, ok := cache.Get("https://example.com"); !ok || string(val) != "testdata" {
+		t.Errorf("expected refreshed entry to be fresh, got %q, %v", val, ok)
+	}
+
+	time.Sleep(waitTime)
+
+	_, got, ok = cache.Stale("https://example.com")
+	if !ok || got.ETag != `"def"` {
+		t.Errorf("expected refresh to store the new ETag, got %+v, %v", got, ok)
+	}
+	if cache.Refresh("https://example.com/missing", Validators{}) {
+		t.Errorf("expected missing entry not to be refreshed")
+	}
+}
+
+func TestStaleEntriesExpire(t *testing.T) {
+	const baseTime = 5 * time.Millisecond
+	const waitTime = (staleIntervals+2)*baseTime + 5*time.Millisecond
+	cache := NewCache(baseTime)
+	cache.AddWithValidators("https://example.com", []byte("testdata"), Validators{ETag: `"abc"`})
+
+	time.Sleep(waitTime)
+
+	if _, _, ok := cache.Stale("https://example.com"); ok {
+		t.Errorf("expected stale entry to be dropped after %d intervals", staleIntervals)
+	}
+}
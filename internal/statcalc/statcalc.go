@@ -0,0 +1,83 @@
+package statcalc
+
+import (
+	"fmt"
+)
+
+const (
+	MinLevel    = 1
+	MaxLevel    = 100
+	MaxIV       = 31
+	MaxEV       = 252
+	MaxTotalEVs = 510
+)
+
+// Stats lists the six permanent stats in the order PokeAPI uses.
+var Stats = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// Nature names the stats a nature raises and lowers by 10%. Neutral natures
+// leave both empty.
+type Nature struct {
+	Increased string
+	Decreased string
+}
+
+// Modifier returns the nature's effect on stat as a percentage.
+func (n Nature) Modifier(stat string) int {
+	if n.Increased == n.Decreased {
+		return 100
+	}
+
+	switch stat {
+	case n.Increased:
+		return 110
+	case n.Decreased:
+		return 90
+	default:
+		return 100
+	}
+}
+
+// Calculate returns the actual value of a stat using the formulas of the
+// mainline games from generation III onwards.
+func Calculate(stat string, base, iv, ev, level int, nature Nature) int {
+	core := (2*base + iv + ev/4) * level / 100
+
+	if stat == "hp" {
+		// Shedinja always has exactly 1 HP.
+		if base == 1 {
+			return 1
+		}
+		return core + level + 10
+	}
+
+	return (core + 5) * nature.Modifier(stat) / 100
+}
+
+// Validate checks that the level, IVs and EVs are within the games' limits.
+func Validate(level int, ivs, evs []int) error {
+	if level < MinLevel || level > MaxLevel {
+		return fmt.Errorf("level must be between %d and %d", MinLevel, MaxLevel)
+	}
+
+	if len(ivs) != len(Stats) || len(evs) != len(Stats) {
+		return fmt.Errorf("expected %d IVs and EVs", len(Stats))
+	}
+
+	total := 0
+	for i := range Stats {
+		if ivs[i] < 0 || ivs[i] > MaxIV {
+			return fmt.Errorf("%s IV must be between 0 and %d", Stats[i], MaxIV)
+		}
+		if evs[i] < 0 || evs[i] > MaxEV {
+			return fmt.Errorf("%s EV must be between 0 and %d", Stats[i], MaxEV)
+		}
+		total += evs[i]
+	}
+
+	if total > MaxTotalEVs {
+		return fmt.Errorf("EVs add up to %d, more than the maximum of %d", total, MaxTotalEVs)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package statcalc
+
+import (
+	"testing"
+)
+
+func TestCalculate(t *testing.T) {
+	// Garchomp example from Bulbapedia: level 78, Adamant nature.
+	adamant := Nature{Increased: "attack", Decreased: "special-attack"}
+	base := []int{108, 130, 95, 80, 85, 102}
+	ivs := []int{24, 12, 30, 16, 23, 5}
+	evs := []int{74, 190, 91, 48, 84, 23}
+	expected := []int{289, 278, 193, 135, 171, 171}
+
+	for i, stat := range Stats {
+		actual := Calculate(stat, base[i], ivs[i], evs[i], 78, adamant)
+		if actual != expected[i] {
+			t.Errorf("Calculate(%q) == %d, expected %d", stat, actual, expected[i])
+		}
+	}
+}
+
+func TestCalculateShedinja(t *testing.T) {
+	if hp := Calculate("hp", 1, 31, 252, 100, Nature{}); hp != 1 {
+		t.Errorf("expected Shedinja to have 1 HP, got %d", hp)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		level     int
+		ivs       []int
+		evs       []int
+		expectErr bool
+	}{
+		{level: 50, ivs: []int{31, 31, 31, 31, 31, 31}, evs: []int{252, 252, 4, 0, 0, 0}},
+		{level: 0, ivs: []int{31, 31, 31, 31, 31, 31}, evs: []int{0, 0, 0, 0, 0, 0}, expectErr: true},
+		{level: 50, ivs: []int{32, 31, 31, 31, 31, 31}, evs: []int{0, 0, 0, 0, 0, 0}, expectErr: true},
+		{level: 50, ivs: []int{31, 31, 31, 31, 31, 31}, evs: []int{252, 252, 252, 0, 0, 0}, expectErr: true},
+		{level: 50, ivs: []int{31, 31, 31}, evs: []int{0, 0, 0, 0, 0, 0}, expectErr: true},
+	}
+
+	for _, c := range cases {
+		err := Validate(c.level, c.ivs, c.evs)
+		if c.expectErr && err == nil {
+			t.Errorf("Validate(%d, %v, %v) expected an error", c.level, c.ivs, c.evs)
+		}
+		if !c.expectErr && err != nil {
+			t.Errorf("Validate(%d, %v, %v) unexpected error: %v", c.level, c.ivs, c.evs, err)
+		}
+	}
+}
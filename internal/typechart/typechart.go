@@ -0,0 +1,106 @@
+package typechart
+
+import (
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+// Types lists the eighteen battle types in the order the games use.
+var Types = []string{
+	"normal", "fire", "water", "electric", "grass", "ice",
+	"fighting", "poison", "ground", "flying", "psychic", "bug",
+	"rock", "ghost", "dragon", "dark", "steel", "fairy",
+}
+
+// Multipliers lists every combined multiplier a dual-typed defender can
+// take, from most to least effective.
+var Multipliers = []float64{4, 2, 1, 0.5, 0.25, 0}
+
+// Matrix holds the damage multiplier of each attacking type against each
+// defending type. Pairs that were never set are neutral.
+type Matrix struct {
+	multipliers map[string]map[string]float64
+}
+
+type Bucket struct {
+	Multiplier float64
+	Types      []string
+}
+
+func NewMatrix() *Matrix {
+	return &Matrix{
+		multipliers: make(map[string]map[string]float64),
+	}
+}
+
+// FromTypes builds a matrix from the damage relations of the given types.
+// Both the "from" and "to" sides are recorded, so the matrix is complete for
+// any pair that involves one of them.
+func FromTypes(types ...pokeapi.TypeDTO) *Matrix {
+	m := NewMatrix()
+
+	for _, t := range types {
+		relations := t.DamageRelations
+		for _, other := range relations.DoubleDamageTo {
+			m.Set(t.Name, other.Name, 2)
+		}
+		for _, other := range relations.HalfDamageTo {
+			m.Set(t.Name, other.Name, 0.5)
+		}
+		for _, other := range relations.NoDamageTo {
+			m.Set(t.Name, other.Name, 0)
+		}
+		for _, other := range relations.DoubleDamageFrom {
+			m.Set(other.Name, t.Name, 2)
+		}
+		for _, other := range relations.HalfDamageFrom {
+			m.Set(other.Name, t.Name, 0.5)
+		}
+		for _, other := range relations.NoDamageFrom {
+			m.Set(other.Name, t.Name, 0)
+		}
+	}
+
+	return m
+}
+
+func (m *Matrix) Set(attacking, defending string, multiplier float64) {
+	if _, ok := m.multipliers[attacking]; !ok {
+		m.multipliers[attacking] = make(map[string]float64)
+	}
+	m.multipliers[attacking][defending] = multiplier
+}
+
+// Effectiveness returns the multiplier of an attacking type against a
+// defender with one or more types.
+func (m *Matrix) Effectiveness(attacking string, defending ...string) float64 {
+	total := 1.0
+	for _, d := range defending {
+		if multiplier, ok := m.multipliers[attacking][d]; ok {
+			total *= multiplier
+		}
+	}
+	return total
+}
+
+// Defense groups every attacking type by its multiplier against a defender
+// with the given types. Buckets follow the order of Multipliers and empty
+// ones are left out.
+func (m *Matrix) Defense(defending ...string) []Bucket {
+	byMultiplier := make(map[float64][]string)
+	for _, attacking := range Types {
+		multiplier := m.Effectiveness(attacking, defending...)
+		byMultiplier[multiplier] = append(byMultiplier[multiplier], attacking)
+	}
+
+	var buckets []Bucket
+	for _, multiplier := range Multipliers {
+		if types, ok := byMultiplier[multiplier]; ok {
+			buckets = append(buckets, Bucket{
+				Multiplier: multiplier,
+				Types:      types,
+			})
+		}
+	}
+
+	return buckets
+}
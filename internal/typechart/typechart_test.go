@@ -0,0 +1,81 @@
+package typechart
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/thihxm/gopokedex/internal/pokeapi"
+)
+
+const fireJSON = `{
+	"name": "fire",
+	"damage_relations": {
+		"double_damage_from": [{"name": "ground"}, {"name": "rock"}, {"name": "water"}],
+		"half_damage_from": [{"name": "bug"}, {"name": "steel"}, {"name": "fire"}, {"name": "grass"}, {"name": "ice"}, {"name": "fairy"}]
+	}
+}`
+
+const flyingJSON = `{
+	"name": "flying",
+	"damage_relations": {
+		"double_damage_from": [{"name": "rock"}, {"name": "electric"}, {"name": "ice"}],
+		"half_damage_from": [{"name": "fighting"}, {"name": "bug"}, {"name": "grass"}],
+		"no_damage_from": [{"name": "ground"}]
+	}
+}`
+
+func TestDefense(t *testing.T) {
+	var fire, flying pokeapi.TypeDTO
+	if err := json.Unmarshal([]byte(fireJSON), &fire); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(flyingJSON), &flying); err != nil {
+		t.Fatal(err)
+	}
+
+	matrix := FromTypes(fire, flying)
+	buckets := matrix.Defense("fire", "flying")
+
+	expected := []Bucket{
+		{Multiplier: 4, Types: []string{"rock"}},
+		{Multiplier: 2, Types: []string{"water", "electric"}},
+		{Multiplier: 1, Types: []string{"normal", "ice", "poison", "flying", "psychic", "ghost", "dragon", "dark"}},
+		{Multiplier: 0.5, Types: []string{"fire", "fighting", "steel", "fairy"}},
+		{Multiplier: 0.25, Types: []string{"grass", "bug"}},
+		{Multiplier: 0, Types: []string{"ground"}},
+	}
+
+	if len(buckets) != len(expected) {
+		t.Fatalf("expected %d buckets, got %+v", len(expected), buckets)
+	}
+	for i := range buckets {
+		if buckets[i].Multiplier != expected[i].Multiplier || !slices.Equal(buckets[i].Types, expected[i].Types) {
+			t.Errorf("bucket %d == %+v, expected %+v", i, buckets[i], expected[i])
+		}
+	}
+}
+
+func TestEffectiveness(t *testing.T) {
+	matrix := NewMatrix()
+	matrix.Set("electric", "water", 2)
+	matrix.Set("electric", "ground", 0)
+
+	cases := []struct {
+		attacking string
+		defending []string
+		expected  float64
+	}{
+		{attacking: "electric", defending: []string{"water"}, expected: 2},
+		{attacking: "electric", defending: []string{"water", "ground"}, expected: 0},
+		{attacking: "electric", defending: []string{"normal"}, expected: 1},
+		{attacking: "fire", defending: []string{"water"}, expected: 1},
+	}
+
+	for _, c := range cases {
+		actual := matrix.Effectiveness(c.attacking, c.defending...)
+		if actual != c.expected {
+			t.Errorf("Effectiveness(%q, %q) == %v, expected %v", c.attacking, c.defending, actual, c.expected)
+		}
+	}
+}
@@ -2,36 +2,87 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"text/tabwriter"
 
+	"github.com/thihxm/gopokedex/internal/inventory"
 	"github.com/thihxm/gopokedex/internal/pokeapi"
+	"github.com/thihxm/gopokedex/internal/statcalc"
 )
 
 type config struct {
-	Next     *string
-	Previous *string
+	PokeapiClient *pokeapi.Client
+	Bag           *inventory.Inventory
+	Version       *pokeapi.VersionDTO
+	VersionGroup  *pokeapi.VersionGroupDTO
+	Next          *string
+	Previous      *string
+}
+
+type caughtPokemon struct {
+	pokeapi.PokemonDTO
+	HeldItem string
 }
 
 type cliCommand struct {
 	name        string
 	description string
-	callback    func(config *config, params ...string) error
+	callback    func(ctx context.Context, config *config, params ...string) error
 }
 
 const (
-	PokeballBaseRate int = 255
-	MinCatchRate     int = 75
+	PokeballBaseRate int    = 255
+	DefaultPokeball  string = "poke-ball"
+	PokedexLanguage  string = "en"
 )
 
+// fetchConcurrency caps how many requests a command sends at once when it
+// fetches many resources.
+const fetchConcurrency = 8
+
+var starterItems = map[string]int{
+	"poke-ball":     10,
+	"great-ball":    5,
+	"ultra-ball":    2,
+	"potion":        5,
+	"oran-berry":    3,
+	"fire-stone":    1,
+	"water-stone":   1,
+	"thunder-stone": 1,
+	"leaf-stone":    1,
+}
+
+// ballModifiers multiply a species' capture rate. Balls not listed here
+// behave like a regular Poke Ball.
+var ballModifiers = map[string]float64{
+	"great-ball":  1.5,
+	"ultra-ball":  2,
+	"master-ball": 255,
+}
+
 var commands map[string]cliCommand
 var cfg = config{
-	Next:     nil,
-	Previous: nil,
+	PokeapiClient: pokeapi.NewClient(),
+	Bag:           newStarterBag(),
+	Next:          nil,
+	Previous:      nil,
 }
-var pokedex = map[string]pokeapi.PokemonDTO{}
+var pokedex = map[string]caughtPokemon{}
+
+// seen holds the names of every Pokemon encountered while exploring or
+// catching, whether or not it was caught. Names are Pokemon (form) names, as
+// listed by encounters; printPokedexCompletion maps them to species.
+var seen = map[string]bool{}
 
 func main() {
 	commands = map[string]cliCommand{
@@ -47,22 +98,47 @@ func main() {
 		},
 		"map": {
 			name:        "map",
-			description: "Displays the map of the region",
+			description: "Lists the next 20 location areas of every region (use regions to browse by region)",
 			callback:    commandMap,
 		},
 		"mapb": {
 			name:        "mapb",
-			description: "Displays the previous page of the map of the region",
+			description: "Lists the previous 20 location areas of every region",
 			callback:    commandMapb,
 		},
+		"regions": {
+			name:        "regions",
+			description: "Lists the regions of the Pokemon world",
+			callback:    commandRegions,
+		},
+		"region": {
+			name:        "region",
+			description: "Describes a region\n" + "Usage: region <region name>",
+			callback:    commandRegion,
+		},
+		"locations": {
+			name:        "locations",
+			description: "Lists the locations in a region\n" + "Usage: locations <region name>",
+			callback:    commandLocations,
+		},
+		"areas": {
+			name:        "areas",
+			description: "Lists the explorable areas of a location\n" + "Usage: areas <location name>",
+			callback:    commandAreas,
+		},
 		"explore": {
 			name:        "explore",
-			description: "Explores a location area\n" + "Usage: explore <area>",
+			description: "Explores a location area\n" + "Usage: explore <area> [--details]",
 			callback:    commandExplore,
 		},
+		"where": {
+			name:        "where",
+			description: "Tells where to find a Pokemon in the wild\n" + "Usage: where <Pokemon name>",
+			callback:    commandWhere,
+		},
 		"catch": {
 			name:        "catch",
-			description: "Tries to catch a Pokemon\n" + "Usage: catch <Pokemon name>",
+			description: "Tries to catch a Pokemon\n" + "Usage: catch <Pokemon name> [ball]",
 			callback:    commandCatch,
 		},
 		"inspect": {
@@ -70,13 +146,78 @@ func main() {
 			description: "Inspects a caught Pokemon\n" + "Usage: inspect <Pokemon name>",
 			callback:    commandInspect,
 		},
+		"evolutions": {
+			name:        "evolutions",
+			description: "Displays the evolution chain of a Pokemon\n" + "Usage: evolutions <Pokemon name>",
+			callback:    commandEvolutions,
+		},
+		"weakness": {
+			name:        "weakness",
+			description: "Displays how much damage a Pokemon takes from each type\n" + "Usage: weakness <Pokemon name>",
+			callback:    commandWeakness,
+		},
+		"moves": {
+			name:        "moves",
+			description: "Displays the moves a Pokemon can learn\n" + "Usage: moves <Pokemon name> [--version-group <name>] [--method level-up|machine|egg|tutor]",
+			callback:    commandMoves,
+		},
+		"ability": {
+			name:        "ability",
+			description: "Describes an ability and lists the Pokemon that can have it\n" + "Usage: ability <ability name>",
+			callback:    commandAbility,
+		},
+		"bag": {
+			name:        "bag",
+			description: "Displays the items in your bag",
+			callback:    commandBag,
+		},
+		"use": {
+			name:        "use",
+			description: "Uses an item from your bag\n" + "Usage: use <item> [Pokemon name]",
+			callback:    commandUse,
+		},
+		"give": {
+			name:        "give",
+			description: "Gives an item from your bag to a caught Pokemon to hold\n" + "Usage: give <item> <Pokemon name>",
+			callback:    commandGive,
+		},
+		"berry": {
+			name:        "berry",
+			description: "Describes a berry\n" + "Usage: berry <berry name>",
+			callback:    commandBerry,
+		},
+		"version": {
+			name:        "version",
+			description: "Shows or selects the game version used to filter data\n" + "Usage: version [version name|all]",
+			callback:    commandVersion,
+		},
+		"stats": {
+			name:        "stats",
+			description: "Calculates the actual stats of a Pokemon\n" + "Usage: stats <Pokemon name> [--level N] [--nature <name>] [--ivs hp,atk,def,spa,spd,spe] [--evs hp,atk,def,spa,spd,spe]",
+			callback:    commandStats,
+		},
+		"tm": {
+			name:        "tm",
+			description: "Displays the move taught by a TM or HM\n" + "Usage: tm <number> [--version-group <name>]",
+			callback:    commandTM,
+		},
+		"tms": {
+			name:        "tms",
+			description: "Lists the TMs and HMs a Pokemon can learn\n" + "Usage: tms <Pokemon name> [--version-group <name>]",
+			callback:    commandTMs,
+		},
 		"pokedex": {
 			name:        "pokedex",
-			description: "Displays the caught Pokemon",
+			description: "Displays the caught Pokemon, or your progress in a regional Pokedex\n" + "Usage: pokedex [Pokedex name]",
 			callback:    commandPokedex,
 		},
 	}
 
+	running := &interrupter{}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go handleInterrupts(signals, running)
+
 	scanner := bufio.NewScanner(os.Stdin)
 
 	fmt.Print("Pokedex > ")
@@ -92,7 +233,7 @@ func main() {
 		params := cleanedInput[1:]
 
 		if cmd, ok := commands[command]; ok {
-			if err := cmd.callback(&cfg, params...); err != nil {
+			if err := runCommand(running, cmd, &cfg, params...); err != nil {
 				fmt.Println(err)
 			}
 		} else {
@@ -103,17 +244,110 @@ func main() {
 	}
 }
 
+// interrupter lets SIGINT cancel the command that is currently running.
+type interrupter struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// interrupt cancels the running command and reports whether there was one.
+func (in *interrupter) interrupt() bool {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if in.cancel == nil {
+		return false
+	}
+	in.cancel()
+	return true
+}
+
+// handleInterrupts cancels the running command on every signal, or shows a
+// fresh prompt if none is running, so Ctrl-C never ends the session.
+func handleInterrupts(signals <-chan os.Signal, in *interrupter) {
+	for range signals {
+		if !in.interrupt() {
+			fmt.Print("\nPokedex > ")
+		}
+	}
+}
+
+// runCommand executes cmd with a context that in can cancel, so Ctrl-C
+// aborts the in-flight command without ending the session.
+func runCommand(in *interrupter, cmd cliCommand, config *config, params ...string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	in.mu.Lock()
+	in.cancel = cancel
+	in.mu.Unlock()
+	defer func() {
+		in.mu.Lock()
+		in.cancel = nil
+		in.mu.Unlock()
+		cancel()
+	}()
+
+	err := cmd.callback(ctx, config, params...)
+	if errors.Is(err, context.Canceled) {
+		fmt.Println()
+		return fmt.Errorf("%s cancelled", cmd.name)
+	}
+	return err
+}
+
 func cleanInput(text string) []string {
 	return strings.Fields(strings.ToLower(text))
 }
 
-func commandExit(config *config, params ...string) error {
+// parseArgs splits command params into positional arguments and
+// "--name value" (or "--name=value") flags. Flags not listed in allowed are
+// rejected.
+func parseArgs(params []string, allowed ...string) ([]string, map[string]string, error) {
+	return parseArgsWithSwitches(params, nil, allowed...)
+}
+
+// parseArgsWithSwitches is parseArgs for commands that also take boolean
+// "--name" flags. Switches that are present map to "true".
+func parseArgsWithSwitches(params []string, switches []string, allowed ...string) ([]string, map[string]string, error) {
+	args := []string{}
+	flags := map[string]string{}
+
+	for i := 0; i < len(params); i++ {
+		param := params[i]
+		if !strings.HasPrefix(param, "--") {
+			args = append(args, param)
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(param, "--"), "=")
+		if slices.Contains(switches, name) {
+			if hasValue {
+				return nil, nil, fmt.Errorf("--%s takes no value", name)
+			}
+			flags[name] = "true"
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			return nil, nil, fmt.Errorf("unknown flag --%s", name)
+		}
+		if !hasValue {
+			if i+1 >= len(params) {
+				return nil, nil, fmt.Errorf("missing value for --%s", name)
+			}
+			i++
+			value = params[i]
+		}
+		flags[name] = value
+	}
+
+	return args, flags, nil
+}
+
+func commandExit(ctx context.Context, config *config, params ...string) error {
 	fmt.Println("Closing the Pokedex... Goodbye!")
 	os.Exit(0)
 	return nil
 }
 
-func commandHelp(config *config, params ...string) error {
+func commandHelp(ctx context.Context, config *config, params ...string) error {
 	fmt.Println("Welcome to the Pokedex!")
 	fmt.Print("Usage:\n\n")
 	for _, cmd := range commands {
@@ -122,13 +356,13 @@ func commandHelp(config *config, params ...string) error {
 	return nil
 }
 
-func commandMap(config *config, params ...string) error {
+func commandMap(ctx context.Context, config *config, params ...string) error {
 	if config.Next == nil && config.Previous != nil {
 		fmt.Println("you're on the last page")
 		return nil
 	}
 
-	locationArea, err := pokeapi.GetLocationArea(config.Next)
+	locationArea, err := config.PokeapiClient.GetLocationArea(ctx, config.Next)
 	if err != nil {
 		return fmt.Errorf("failed to get location area: %w", err)
 	}
@@ -143,13 +377,13 @@ func commandMap(config *config, params ...string) error {
 	return nil
 }
 
-func commandMapb(config *config, params ...string) error {
+func commandMapb(ctx context.Context, config *config, params ...string) error {
 	if config.Previous == nil {
 		fmt.Println("you're on the first page")
 		return nil
 	}
 
-	locationArea, err := pokeapi.GetLocationArea(config.Previous)
+	locationArea, err := config.PokeapiClient.GetLocationArea(ctx, config.Previous)
 	if err != nil {
 		return fmt.Errorf("failed to get location area: %w", err)
 	}
@@ -164,44 +398,145 @@ func commandMapb(config *config, params ...string) error {
 	return nil
 }
 
-func commandExplore(config *config, params ...string) error {
-	if len(params) == 0 {
+func commandExplore(ctx context.Context, config *config, params ...string) error {
+	args, flags, err := parseArgsWithSwitches(params, []string{"details"})
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
 		return fmt.Errorf("missing area")
 	}
-	area := params[0]
+	area := args[0]
 
-	locationAreaDetails, err := pokeapi.GetLocationAreaDetails(area)
+	locationAreaDetails, err := config.PokeapiClient.GetLocationAreaDetails(ctx, area)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no location area named %s", area)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get location area (%s) details: %w", area, err)
 	}
 
 	fmt.Printf("Exploring %s...\n", locationAreaDetails.Name)
-	fmt.Println("Found Pokemon:")
+	if config.Version != nil {
+		fmt.Printf("Found Pokemon (%s):\n", config.Version.Name)
+	} else {
+		fmt.Println("Found Pokemon:")
+	}
+	names := []string{}
 	for _, pokemonEncounters := range locationAreaDetails.PokemonEncounters {
-		fmt.Printf("- %s\n", pokemonEncounters.Pokemon.Name)
+		if config.Version != nil && !pokemonEncounters.InVersion(config.Version.Name) {
+			continue
+		}
+		seen[pokemonEncounters.Pokemon.Name] = true
+		names = append(names, pokemonEncounters.Pokemon.Name)
+	}
+
+	if flags["details"] == "true" {
+		return printEncounterDetails(ctx, config, names)
+	}
+	for _, name := range names {
+		fmt.Printf("- %s\n", name)
 	}
 
 	return nil
 }
 
-func commandCatch(config *config, params ...string) error {
+// printEncounterDetails fetches every encountered Pokemon at once and prints
+// their types and base stats. Pokemon that fail to load are reported inline.
+func printEncounterDetails(ctx context.Context, config *config, names []string) error {
+	results := config.PokeapiClient.GetPokemonBatch(ctx, names, fetchConcurrency)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	generation, filterTypes := selectedGeneration(config)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "POKEMON\tTYPES\tHP\tATK\tDEF\tSPA\tSPD\tSPE")
+	for i, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(w, "%s\tfailed to load: %v\n", names[i], result.Err)
+			continue
+		}
+		pokemon := result.Value
+
+		types := []string{}
+		if filterTypes {
+			types = typesInGeneration(pokemon, generation)
+		} else {
+			for _, t := range pokemon.Types {
+				types = append(types, t.Type.Name)
+			}
+		}
+
+		baseStats := map[string]int{}
+		for _, stat := range pokemon.Stats {
+			baseStats[stat.Stat.Name] = stat.BaseStat
+		}
+		fmt.Fprintf(w, "%s\t%s", pokemon.Name, strings.Join(types, "/"))
+		for _, stat := range statcalc.Stats {
+			fmt.Fprintf(w, "\t%d", baseStats[stat])
+		}
+		fmt.Fprintln(w)
+	}
+
+	return w.Flush()
+}
+
+func commandCatch(ctx context.Context, config *config, params ...string) error {
 	if len(params) == 0 {
 		return fmt.Errorf("missing Pokemon name")
 	}
 	pokemonName := params[0]
+	ballName := DefaultPokeball
+	if len(params) > 1 {
+		ballName = params[1]
+	}
 
-	pokemon, err := pokeapi.GetPokemon(pokemonName)
+	if _, ok := pokedex[pokemonName]; ok {
+		return fmt.Errorf("you already have a %s", pokemonName)
+	}
+	if config.Bag.Count(ballName) == 0 {
+		return fmt.Errorf("you have no %s left", ballName)
+	}
+
+	ball, err := config.PokeapiClient.GetItem(ctx, ballName)
+	if err != nil {
+		return fmt.Errorf("failed to get item (%s): %w", ballName, err)
+	}
+	if !isPokeball(ball) {
+		return fmt.Errorf("%s is not a Poke Ball", ballName)
+	}
+
+	pokemon, err := config.PokeapiClient.GetPokemon(ctx, pokemonName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", pokemonName)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get Pokemon (%s): %w", pokemonName, err)
 	}
 
-	fmt.Printf("Throwing a Pokeball at %s...\n", pokemonName)
+	species, err := pokemon.Species.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon species (%s): %w", pokemon.Species.Name, err)
+	}
 
-	catchRate := (rand.Intn(PokeballBaseRate) * 100) / pokemon.BaseExperience
+	if err := config.Bag.Remove(ballName, 1); err != nil {
+		return err
+	}
+	seen[pokemon.Name] = true
 
-	if catchRate >= MinCatchRate {
-		pokedex[pokemonName] = pokemon
+	fmt.Printf("Throwing a %s at %s...\n", ballName, pokemonName)
+
+	if rand.Intn(PokeballBaseRate) < catchRate(species.CaptureRate, ballName) {
+		caught := caughtPokemon{
+			PokemonDTO: pokemon,
+			HeldItem:   rollHeldItem(pokemon),
+		}
+		pokedex[pokemonName] = caught
 		fmt.Printf("%s was caught!\n", pokemonName)
+		if caught.HeldItem != "" {
+			fmt.Printf("It was holding a %s.\n", caught.HeldItem)
+		}
 		fmt.Println("You may now inspect it with the inspect command.")
 	} else {
 		fmt.Printf("%s escaped!\n", pokemonName)
@@ -210,7 +545,32 @@ func commandCatch(config *config, params ...string) error {
 	return nil
 }
 
-func commandInspect(config *config, params ...string) error {
+// catchRate scales the species' capture rate by the ball's modifier. A
+// Pokemon is caught when a roll in [0, PokeballBaseRate) falls below it.
+func catchRate(captureRate int, ballName string) int {
+	modifier, ok := ballModifiers[ballName]
+	if !ok {
+		modifier = 1
+	}
+	return int(float64(captureRate) * modifier)
+}
+
+// rollHeldItem picks the item a wild Pokemon is holding, if any, using the
+// rarity (a percentage) PokeAPI reports for each of its held items.
+func rollHeldItem(pokemon pokeapi.PokemonDTO) string {
+	for _, heldItem := range pokemon.HeldItems {
+		rarity := 0
+		for _, details := range heldItem.VersionDetails {
+			rarity = max(rarity, details.Rarity)
+		}
+		if rand.Intn(100) < rarity {
+			return heldItem.Item.Name
+		}
+	}
+	return ""
+}
+
+func commandInspect(ctx context.Context, config *config, params ...string) error {
 	if len(params) == 0 {
 		return fmt.Errorf("missing Pokemon name")
 	}
@@ -222,31 +582,208 @@ func commandInspect(config *config, params ...string) error {
 		return nil
 	}
 
+	species, err := pokemon.Species.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get Pokemon species (%s): %w", pokemon.Species.Name, err)
+	}
+
 	fmt.Printf("Name: %s\n", pokemon.Name)
+	if genus := species.Genus(PokedexLanguage); genus != "" {
+		fmt.Printf("Genus: %s\n", genus)
+	}
 	fmt.Printf("Height: %d\n", pokemon.Height)
 	fmt.Printf("Weight: %d\n", pokemon.Weight)
+	if pokemon.HeldItem != "" {
+		fmt.Printf("Held item: %s\n", pokemon.HeldItem)
+	}
 	fmt.Println("Stats:")
 	for _, stat := range pokemon.Stats {
 		fmt.Printf(" - %s: %d\n", stat.Stat.Name, stat.BaseStat)
 	}
-	fmt.Println("Types:")
-	for _, t := range pokemon.Types {
-		fmt.Printf(" - %s\n", t.Type.Name)
+	if generation, ok := selectedGeneration(config); ok {
+		fmt.Printf("Types (%s):\n", config.VersionGroup.Generation.Name)
+		for _, name := range typesInGeneration(pokemon.PokemonDTO, generation) {
+			fmt.Printf(" - %s\n", name)
+		}
+	} else {
+		fmt.Println("Types:")
+		for _, t := range pokemon.Types {
+			fmt.Printf(" - %s\n", t.Type.Name)
+		}
+	}
+	fmt.Println("Abilities:")
+	for _, a := range pokemon.Abilities {
+		ability, err := a.Ability.Resolve(ctx, config.PokeapiClient)
+		if err != nil {
+			return fmt.Errorf("failed to get ability (%s): %w", a.Ability.Name, err)
+		}
+
+		name := ability.Name
+		if a.IsHidden {
+			name += " (hidden)"
+		}
+		fmt.Printf(" - %d: %s", a.Slot, name)
+		if shortEffect := ability.ShortEffect(PokedexLanguage); shortEffect != "" {
+			fmt.Printf(": %s", shortEffect)
+		}
+		fmt.Println()
+	}
+	eggGroups := []string{}
+	for _, e := range species.EggGroups {
+		eggGroup, err := e.Resolve(ctx, config.PokeapiClient)
+		if err != nil {
+			return fmt.Errorf("failed to get egg group (%s): %w", e.Name, err)
+		}
+		eggGroups = append(eggGroups, eggGroup.LocalizedName(PokedexLanguage))
+	}
+	fmt.Printf("Egg groups: %s\n", strings.Join(eggGroups, ", "))
+	fmt.Printf("Gender ratio: %s\n", formatGenderRate(species.GenderRate))
+	fmt.Printf("Hatch steps: %d\n", hatchSteps(species.HatchCounter))
+
+	growthRate, err := species.GrowthRate.Resolve(ctx, config.PokeapiClient)
+	if err != nil {
+		return fmt.Errorf("failed to get growth rate (%s): %w", species.GrowthRate.Name, err)
+	}
+	if experience, ok := growthRate.ExperienceForLevel(100); ok {
+		fmt.Printf("Growth rate: %s (%d exp to level 100)\n", growthRate.Name, experience)
+	} else {
+		fmt.Printf("Growth rate: %s\n", growthRate.Name)
+	}
+
+	if flavorText := species.FlavorText(PokedexLanguage); flavorText != "" {
+		fmt.Println("Pokedex entry:")
+		fmt.Printf(" %s\n", flavorText)
 	}
 
 	return nil
 }
 
-func commandPokedex(config *config, params ...string) error {
+// formatGenderRate describes a species' gender_rate, which is the chance of
+// being female in eighths, or -1 for genderless species.
+func formatGenderRate(genderRate int) string {
+	if genderRate < 0 {
+		return "genderless"
+	}
+
+	female := float64(genderRate) / 8 * 100
+	return fmt.Sprintf("%s%% male, %s%% female",
+		strconv.FormatFloat(100-female, 'f', -1, 64),
+		strconv.FormatFloat(female, 'f', -1, 64),
+	)
+}
+
+// hatchSteps converts a species' hatch_counter into the number of steps
+// needed to hatch its egg.
+func hatchSteps(hatchCounter int) int {
+	return 255 * (hatchCounter + 1)
+}
+
+func commandPokedex(ctx context.Context, config *config, params ...string) error {
+	if len(params) > 0 {
+		return printPokedexCompletion(ctx, config, params[0])
+	}
+
 	if len(pokedex) == 0 {
 		fmt.Println("you have not caught any Pokemon")
 		return nil
 	}
 
 	fmt.Println("Your Pokedex:")
-	for pokemon, _ := range pokedex {
+	for _, pokemon := range slices.Sorted(maps.Keys(pokedex)) {
 		fmt.Printf(" - %s\n", pokemon)
 	}
 
 	return nil
 }
+
+// printPokedexCompletion shows how much of a regional Pokedex has been
+// caught and seen, listing the missing entries in Pokedex order.
+func printPokedexCompletion(ctx context.Context, config *config, pokedexName string) error {
+	regionalDex, err := config.PokeapiClient.GetPokedex(ctx, pokedexName)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokedex named %s", pokedexName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Pokedex (%s): %w", pokedexName, err)
+	}
+
+	caughtSpecies := map[string]bool{}
+	for _, pokemon := range pokedex {
+		caughtSpecies[pokemon.Species.Name] = true
+	}
+
+	entries := regionalDex.PokemonEntries
+	dexSpecies := map[string]bool{}
+	for _, entry := range entries {
+		dexSpecies[entry.PokemonSpecies.Name] = true
+	}
+
+	// Default forms share their species' name, so only other forms need to
+	// be looked up.
+	seenSpecies := map[string]bool{}
+	forms := []string{}
+	for name := range seen {
+		if dexSpecies[name] {
+			seenSpecies[name] = true
+		} else {
+			forms = append(forms, name)
+		}
+	}
+	unknown, err := addSpeciesOf(ctx, config.PokeapiClient, forms, seenSpecies)
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(entries, func(a, b pokeapi.PokemonEntryDTO) int {
+		return a.EntryNumber - b.EntryNumber
+	})
+
+	caughtCount := 0
+	seenCount := 0
+	missing := []string{}
+	for _, entry := range entries {
+		species := entry.PokemonSpecies.Name
+		switch {
+		case caughtSpecies[species]:
+			caughtCount++
+			seenCount++
+		case seenSpecies[species]:
+			seenCount++
+			missing = append(missing, fmt.Sprintf(" #%03d %s (seen)", entry.EntryNumber, species))
+		default:
+			missing = append(missing, fmt.Sprintf(" #%03d %s", entry.EntryNumber, species))
+		}
+	}
+
+	fmt.Printf("%s: %d/%d caught, %d seen\n", regionalDex.LocalizedName(PokedexLanguage), caughtCount, len(entries), seenCount)
+	if len(missing) > 0 {
+		fmt.Println("Missing:")
+		for _, line := range missing {
+			fmt.Println(line)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		fmt.Printf("Unknown seen Pokemon (not counted): %s\n", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// addSpeciesOf adds the species of the named Pokemon to species, so that
+// forms such as basculin-red-striped count as their species. It returns the
+// names that couldn't be looked up.
+func addSpeciesOf(ctx context.Context, client *pokeapi.Client, pokemonNames []string, species map[string]bool) ([]string, error) {
+	unknown := []string{}
+	for i, result := range client.GetPokemonBatch(ctx, pokemonNames, fetchConcurrency) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if result.Err != nil {
+			unknown = append(unknown, pokemonNames[i])
+			continue
+		}
+		species[result.Value.Species.Name] = true
+	}
+	return unknown, nil
+}
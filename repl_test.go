@@ -1,7 +1,21 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/thihxm/gopokedex/internal/inventory"
+	"github.com/thihxm/gopokedex/internal/pokeapi"
 )
 
 func TestCleanInput(t *testing.T) {
@@ -40,3 +54,882 @@ func TestCleanInput(t *testing.T) {
 		}
 	}
 }
+
+func TestCommandMap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprintf(w, `{"count": 40, "next": "http://%s/location-area?offset=20", "previous": null, "results": [{"name": "canalave-city-area"}]}`, r.Host)
+		case "20":
+			fmt.Fprintf(w, `{"count": 40, "next": null, "previous": "http://%s/location-area", "results": [{"name": "eterna-city-area"}]}`, r.Host)
+		default:
+			t.Errorf("unexpected request %q", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	config := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	if err := commandMap(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Next == nil || *config.Next != server.URL+"/location-area?offset=20" {
+		t.Errorf("expected next page to be set, got %v", config.Next)
+	}
+	if config.Previous != nil {
+		t.Errorf("expected no previous page, got %q", *config.Previous)
+	}
+
+	if err := commandMap(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Next != nil {
+		t.Errorf("expected no next page, got %q", *config.Next)
+	}
+	if config.Previous == nil || *config.Previous != server.URL+"/location-area" {
+		t.Errorf("expected previous page to be set, got %v", config.Previous)
+	}
+}
+
+func TestRegionDrillDown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/region":
+			w.Write([]byte(`{"count": 2, "next": null, "previous": null, "results": [{"name": "kanto"}, {"name": "johto"}]}`))
+		case "/region/kanto":
+			w.Write([]byte(`{"id": 1, "name": "kanto", "main_generation": {"name": "generation-i"},
+				"locations": [{"name": "pallet-town"}, {"name": "kanto-route-1"}],
+				"pokedexes": [{"name": "kanto"}], "version_groups": [{"name": "red-blue"}]}`))
+		case "/location/kanto-route-1":
+			w.Write([]byte(`{"id": 88, "name": "kanto-route-1", "areas": [{"name": "kanto-route-1-area"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	cases := []struct {
+		command  func(context.Context, *config, ...string) error
+		params   []string
+		expected string
+	}{
+		{command: commandRegions, expected: "Regions:\n - kanto\n - johto\n"},
+		{command: commandRegion, params: []string{"kanto"}, expected: "Name: kanto\nMain generation: generation-i\nVersion groups:\n - red-blue\nPokedexes:\n - kanto\nLocations: 2 (list them with: locations kanto)\n"},
+		{command: commandLocations, params: []string{"kanto"}, expected: "Locations in kanto:\n - pallet-town\n - kanto-route-1\n"},
+		{command: commandAreas, params: []string{"kanto-route-1"}, expected: "Areas in kanto-route-1:\n - kanto-route-1-area\nExplore one with: explore <area>\n"},
+	}
+
+	for _, c := range cases {
+		var err error
+		output := captureStdout(t, func() {
+			err = c.command(ctx, testConfig, c.params...)
+		})
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", c.params, err)
+		}
+		if output != c.expected {
+			t.Errorf("printed\n%s\nexpected\n%s", output, c.expected)
+		}
+	}
+
+	if err := commandRegion(ctx, testConfig, "orre"); err == nil || err.Error() != "no region named orre" {
+		t.Errorf("expected unknown region error, got %v", err)
+	}
+	if err := commandLocations(ctx, testConfig, "orre"); err == nil || err.Error() != "no region named orre" {
+		t.Errorf("expected unknown region error, got %v", err)
+	}
+	if err := commandAreas(ctx, testConfig, "pyrite-town"); err == nil || err.Error() != "no location named pyrite-town" {
+		t.Errorf("expected unknown location error, got %v", err)
+	}
+}
+
+func TestRunCommandCancelled(t *testing.T) {
+	entered := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/region/kanto":
+			entered <- struct{}{}
+			<-r.Context().Done()
+		case "/location/kanto-route-1":
+			w.Write([]byte(`{"name": "kanto-route-1", "areas": [{"name": "kanto-route-1-area"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+	running := &interrupter{}
+	signals := make(chan os.Signal)
+	defer close(signals)
+	go handleInterrupts(signals, running)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- runCommand(running, cliCommand{name: "region", callback: commandRegion}, testConfig, "kanto")
+	}()
+
+	<-entered
+	signals <- os.Interrupt
+
+	if err := <-result; err == nil || err.Error() != "region cancelled" {
+		t.Errorf("expected region to be cancelled, got %v", err)
+	}
+	if running.interrupt() {
+		t.Errorf("expected no command to be running after cancellation")
+	}
+
+	output := captureStdout(t, func() {
+		if err := runCommand(running, cliCommand{name: "areas", callback: commandAreas}, testConfig, "kanto-route-1"); err != nil {
+			t.Errorf("expected the session to keep working, got %v", err)
+		}
+	})
+	if !strings.Contains(output, "kanto-route-1-area") {
+		t.Errorf("unexpected output after cancellation:\n%s", output)
+	}
+}
+
+func TestDescribeEvolutionDetail(t *testing.T) {
+	cases := []struct {
+		detail   pokeapi.EvolutionDetailDTO
+		expected string
+	}{
+		{
+			detail:   evolutionDetail(`{"trigger": {"name": "level-up"}, "min_level": 16}`),
+			expected: "level 16",
+		},
+		{
+			detail:   evolutionDetail(`{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}`),
+			expected: "use thunder-stone",
+		},
+		{
+			detail:   evolutionDetail(`{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"}`),
+			expected: "level up, happiness 160+, during the day",
+		},
+		{
+			detail:   evolutionDetail(`{"trigger": {"name": "trade"}, "held_item": {"name": "metal-coat"}}`),
+			expected: "trade, holding metal-coat",
+		},
+	}
+
+	for _, c := range cases {
+		actual := describeEvolutionDetail(c.detail)
+		if actual != c.expected {
+			t.Errorf("describeEvolutionDetail(%+v) == %q, expected %q", c.detail, actual, c.expected)
+		}
+	}
+}
+
+func evolutionDetail(data string) pokeapi.EvolutionDetailDTO {
+	var detail pokeapi.EvolutionDetailDTO
+	if err := json.Unmarshal([]byte(data), &detail); err != nil {
+		panic(err)
+	}
+	return detail
+}
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		params        []string
+		expectedArgs  []string
+		expectedFlags map[string]string
+		expectErr     bool
+	}{
+		{
+			params:        []string{"pikachu"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: map[string]string{},
+		},
+		{
+			params:        []string{"pikachu", "--method", "machine", "--version-group=red-blue"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: map[string]string{"method": "machine", "version-group": "red-blue"},
+		},
+		{
+			params:    []string{"pikachu", "--method"},
+			expectErr: true,
+		},
+		{
+			params:    []string{"pikachu", "--level", "50"},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		args, flags, err := parseArgs(c.params, "method", "version-group")
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("parseArgs(%q) expected an error", c.params)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseArgs(%q) unexpected error: %v", c.params, err)
+			continue
+		}
+		if !slices.Equal(args, c.expectedArgs) {
+			t.Errorf("parseArgs(%q) args == %q, expected %q", c.params, args, c.expectedArgs)
+		}
+		if !maps.Equal(flags, c.expectedFlags) {
+			t.Errorf("parseArgs(%q) flags == %q, expected %q", c.params, flags, c.expectedFlags)
+		}
+	}
+}
+
+func TestParseArgsWithSwitches(t *testing.T) {
+	args, flags, err := parseArgsWithSwitches([]string{"--details", "canalave-city-area", "--method=walk"}, []string{"details"}, "method")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(args, []string{"canalave-city-area"}) {
+		t.Errorf("args == %q, expected %q", args, []string{"canalave-city-area"})
+	}
+	if expected := map[string]string{"details": "true", "method": "walk"}; !maps.Equal(flags, expected) {
+		t.Errorf("flags == %q, expected %q", flags, expected)
+	}
+
+	for _, params := range [][]string{
+		{"--detail", "canalave-city-area"},
+		{"--details=yes", "canalave-city-area"},
+	} {
+		if _, _, err := parseArgsWithSwitches(params, []string{"details"}); err == nil {
+			t.Errorf("parseArgsWithSwitches(%q) expected an error", params)
+		}
+	}
+}
+
+func TestCommandGive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/item/leftovers":
+			w.Write([]byte(`{"name": "leftovers", "attributes": [{"name": "holdable"}]}`))
+		case "/item/potion":
+			w.Write([]byte(`{"name": "potion", "attributes": [{"name": "usable-overworld"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+		Bag:           inventory.New(),
+	}
+	config.Bag.Add("leftovers", 1)
+	config.Bag.Add("potion", 1)
+
+	pokedex = map[string]caughtPokemon{
+		"snorlax": {HeldItem: "chesto-berry"},
+	}
+	defer func() { pokedex = map[string]caughtPokemon{} }()
+
+	if err := commandGive(context.Background(), config, "potion", "snorlax"); err == nil {
+		t.Errorf("expected error giving an item that can't be held")
+	}
+
+	if err := commandGive(context.Background(), config, "leftovers", "snorlax"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held := pokedex["snorlax"].HeldItem; held != "leftovers" {
+		t.Errorf("expected snorlax to hold leftovers, got %q", held)
+	}
+	if count := config.Bag.Count("leftovers"); count != 0 {
+		t.Errorf("expected leftovers to leave the bag, got %d", count)
+	}
+	if count := config.Bag.Count("chesto-berry"); count != 1 {
+		t.Errorf("expected previously held item to return to the bag, got %d", count)
+	}
+}
+
+func TestCommandCatchAlreadyCaught(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %q", r.URL)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	config := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+		Bag:           inventory.New(),
+	}
+	config.Bag.Add("poke-ball", 1)
+
+	pokedex = map[string]caughtPokemon{
+		"pikachu": {HeldItem: "leftovers"},
+	}
+	defer func() { pokedex = map[string]caughtPokemon{} }()
+
+	if err := commandCatch(context.Background(), config, "pikachu"); err == nil {
+		t.Errorf("expected error catching a Pokemon that was already caught")
+	}
+	if held := pokedex["pikachu"].HeldItem; held != "leftovers" {
+		t.Errorf("expected caught pikachu to keep holding leftovers, got %q", held)
+	}
+	if count := config.Bag.Count("poke-ball"); count != 1 {
+		t.Errorf("expected the poke-ball to stay in the bag, got %d", count)
+	}
+}
+
+func TestCommandUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/item/potion":
+			w.Write([]byte(`{"name": "potion", "category": {"name": "healing"}, "attributes": [{"name": "usable-overworld"}]}`))
+		case "/item/repel":
+			w.Write([]byte(`{"name": "repel", "category": {"name": "spelunking"}, "attributes": [{"name": "usable-overworld"}]}`))
+		case "/item/fire-stone":
+			w.Write([]byte(`{"name": "fire-stone", "category": {"name": "evolution"}, "attributes": [{"name": "usable-overworld"}]}`))
+		case "/pokemon-species/133/":
+			fmt.Fprintf(w, `{"id": 133, "name": "eevee", "evolution_chain": {"url": "http://%s/evolution-chain/67/"}}`, r.Host)
+		case "/pokemon-species/37/":
+			fmt.Fprintf(w, `{"id": 37, "name": "vulpix", "evolution_chain": {"url": "http://%s/evolution-chain/15/"}}`, r.Host)
+		case "/evolution-chain/67/":
+			w.Write([]byte(`{"id": 67, "chain": {"species": {"name": "eevee"}, "evolves_to": [
+				{"species": {"name": "flareon"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "fire-stone"}}]}
+			]}}`))
+		case "/evolution-chain/15/":
+			w.Write([]byte(`{"id": 15, "chain": {"species": {"name": "vulpix"}, "evolves_to": [
+				{"species": {"name": "ninetales"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "fire-stone"}}]}
+			]}}`))
+		case "/pokemon/flareon":
+			w.Write([]byte(`{"id": 136, "name": "flareon", "species": {"name": "flareon"}}`))
+		case "/pokemon/ninetales":
+			w.Write([]byte(`{"id": 38, "name": "ninetales", "species": {"name": "ninetales"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	config := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+		Bag:           inventory.New(),
+	}
+	config.Bag.Add("potion", 1)
+	config.Bag.Add("repel", 1)
+	config.Bag.Add("fire-stone", 2)
+
+	caught := func(name string, speciesID int, heldItem string) caughtPokemon {
+		pokemon := caughtPokemon{HeldItem: heldItem}
+		pokemon.Name = name
+		pokemon.Species = pokeapi.NamedAPIResource[pokeapi.PokemonSpeciesDTO]{
+			Name: name,
+			URL:  fmt.Sprintf("%s/pokemon-species/%d/", server.URL, speciesID),
+		}
+		return pokemon
+	}
+	pokedex = map[string]caughtPokemon{
+		"eevee":     caught("eevee", 133, "soothe-bell"),
+		"vulpix":    caught("vulpix", 37, ""),
+		"ninetales": caught("ninetales", 38, "charcoal"),
+	}
+	defer func() { pokedex = map[string]caughtPokemon{} }()
+
+	if err := commandUse(ctx, config, "potion"); err == nil {
+		t.Errorf("expected error using a potion without a target")
+	}
+	if err := commandUse(ctx, config, "potion", "eevee"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count := config.Bag.Count("potion"); count != 1 {
+		t.Errorf("expected potion with no effect to stay in the bag, got %d", count)
+	}
+
+	if err := commandUse(ctx, config, "repel"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count := config.Bag.Count("repel"); count != 1 {
+		t.Errorf("expected repel with no effect to stay in the bag, got %d", count)
+	}
+
+	if err := commandUse(ctx, config, "fire-stone", "vulpix"); err == nil {
+		t.Errorf("expected error evolving into a Pokemon that was already caught")
+	}
+	if held := pokedex["ninetales"].HeldItem; held != "charcoal" {
+		t.Errorf("expected caught ninetales to be left untouched, got held item %q", held)
+	}
+	if count := config.Bag.Count("fire-stone"); count != 2 {
+		t.Errorf("expected fire-stone to stay in the bag, got %d", count)
+	}
+
+	if err := commandUse(ctx, config, "fire-stone", "eevee"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	flareon, ok := pokedex["flareon"]
+	if !ok || flareon.HeldItem != "soothe-bell" {
+		t.Errorf("expected eevee to evolve into flareon keeping its held item, got %+v", flareon)
+	}
+	if _, ok := pokedex["eevee"]; ok {
+		t.Errorf("expected eevee to leave the pokedex")
+	}
+	if count := config.Bag.Count("fire-stone"); count != 1 {
+		t.Errorf("expected one fire-stone to be used, got %d", count)
+	}
+}
+
+func TestTypesInGeneration(t *testing.T) {
+	var clefairy pokeapi.PokemonDTO
+	err := json.Unmarshal([]byte(`{
+		"name": "clefairy",
+		"types": [{"slot": 1, "type": {"name": "fairy"}}],
+		"past_types": [{
+			"generation": {"name": "generation-v", "url": "https://pokeapi.co/api/v2/generation/5/"},
+			"types": [{"slot": 1, "type": {"name": "normal"}}]
+		}]
+	}`), &clefairy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		generation int
+		expected   []string
+	}{
+		{generation: 1, expected: []string{"normal"}},
+		{generation: 5, expected: []string{"normal"}},
+		{generation: 6, expected: []string{"fairy"}},
+		{generation: 9, expected: []string{"fairy"}},
+	}
+
+	for _, c := range cases {
+		actual := typesInGeneration(clefairy, c.generation)
+		if !slices.Equal(actual, c.expected) {
+			t.Errorf("typesInGeneration(clefairy, %d) == %q, expected %q", c.generation, actual, c.expected)
+		}
+	}
+}
+
+func TestFormatGenderRate(t *testing.T) {
+	cases := []struct {
+		genderRate int
+		expected   string
+	}{
+		{genderRate: -1, expected: "genderless"},
+		{genderRate: 0, expected: "100% male, 0% female"},
+		{genderRate: 1, expected: "87.5% male, 12.5% female"},
+		{genderRate: 4, expected: "50% male, 50% female"},
+		{genderRate: 8, expected: "0% male, 100% female"},
+	}
+
+	for _, c := range cases {
+		actual := formatGenderRate(c.genderRate)
+		if actual != c.expected {
+			t.Errorf("formatGenderRate(%d) == %q, expected %q", c.genderRate, actual, c.expected)
+		}
+	}
+}
+
+func TestMachineItemName(t *testing.T) {
+	cases := []struct {
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{input: "24", expected: "tm24"},
+		{input: "7", expected: "tm07"},
+		{input: "tm100", expected: "tm100"},
+		{input: "hm5", expected: "hm05"},
+		{input: "thunderbolt", expectErr: true},
+	}
+
+	for _, c := range cases {
+		actual, err := machineItemName(c.input)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("machineItemName(%q) expected an error", c.input)
+			}
+			continue
+		}
+		if err != nil || actual != c.expected {
+			t.Errorf("machineItemName(%q) == %q, %v, expected %q", c.input, actual, err, c.expected)
+		}
+	}
+}
+
+func TestSummarizeEncounters(t *testing.T) {
+	var encounters []pokeapi.LocationAreaEncounterDTO
+	err := json.Unmarshal([]byte(`[
+		{
+			"location_area": {"name": "viridian-forest-area"},
+			"version_details": [
+				{
+					"version": {"name": "yellow", "url": "https://pokeapi.co/api/v2/version/3/"},
+					"encounter_details": [
+						{"chance": 5, "min_level": 3, "max_level": 3, "method": {"name": "walk"}},
+						{"chance": 5, "min_level": 5, "max_level": 5, "method": {"name": "walk"}}
+					]
+				},
+				{
+					"version": {"name": "red", "url": "https://pokeapi.co/api/v2/version/1/"},
+					"encounter_details": [
+						{"chance": 5, "min_level": 3, "max_level": 5, "method": {"name": "walk"}}
+					]
+				}
+			]
+		},
+		{
+			"location_area": {"name": "sinnoh-route-201-area"},
+			"version_details": [
+				{
+					"version": {"name": "diamond", "url": "https://pokeapi.co/api/v2/version/12/"},
+					"encounter_details": [
+						{"chance": 10, "min_level": 2, "max_level": 2, "method": {"name": "walk"}, "condition_values": [{"name": "time-morning"}, {"name": "swarm-no"}]},
+						{"chance": 10, "min_level": 3, "max_level": 3, "method": {"name": "walk"}, "condition_values": [{"name": "time-morning"}, {"name": "swarm-no"}]},
+						{"chance": 10, "min_level": 2, "max_level": 3, "method": {"name": "walk"}, "condition_values": [{"name": "time-day"}, {"name": "swarm-no"}]},
+						{"chance": 10, "min_level": 2, "max_level": 3, "method": {"name": "walk"}, "condition_values": [{"name": "time-night"}, {"name": "swarm-no"}]}
+					]
+				}
+			]
+		}
+	]`), &encounters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []encounterSummary{
+		{version: "red", versionID: 1, method: "walk", area: "viridian-forest-area", minLevel: 3, maxLevel: 5, chance: 5},
+		{version: "yellow", versionID: 3, method: "walk", area: "viridian-forest-area", minLevel: 3, maxLevel: 5, chance: 10},
+		{version: "diamond", versionID: 12, method: "walk", area: "sinnoh-route-201-area", conditions: "swarm-no, time-day", minLevel: 2, maxLevel: 3, chance: 10},
+		{version: "diamond", versionID: 12, method: "walk", area: "sinnoh-route-201-area", conditions: "swarm-no, time-morning", minLevel: 2, maxLevel: 3, chance: 20},
+		{version: "diamond", versionID: 12, method: "walk", area: "sinnoh-route-201-area", conditions: "swarm-no, time-night", minLevel: 2, maxLevel: 3, chance: 10},
+	}
+
+	actual := summarizeEncounters(encounters)
+	if !slices.Equal(actual, expected) {
+		t.Errorf("summarizeEncounters() == %+v, expected %+v", actual, expected)
+	}
+}
+
+// captureStdout returns everything fn prints to standard output.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+
+	fn()
+	w.Close()
+	return <-output
+}
+
+func TestPokedexCompletion(t *testing.T) {
+	var mu sync.Mutex
+	requested := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/pokedex/kanto":
+			w.Write([]byte(`{"name": "kanto", "names": [{"name": "Kanto", "language": {"name": "en"}}], "pokemon_entries": [
+				{"entry_number": 4, "pokemon_species": {"name": "charmander"}},
+				{"entry_number": 1, "pokemon_species": {"name": "bulbasaur"}},
+				{"entry_number": 16, "pokemon_species": {"name": "pidgey"}},
+				{"entry_number": 7, "pokemon_species": {"name": "squirtle"}},
+				{"entry_number": 25, "pokemon_species": {"name": "pikachu"}}
+			]}`))
+		case "/pokemon/pidgey":
+			w.Write([]byte(`{"name": "pidgey", "species": {"name": "pidgey"}}`))
+		case "/pokemon/pikachu-original-cap":
+			w.Write([]byte(`{"name": "pikachu-original-cap", "species": {"name": "pikachu"}}`))
+		case "/pokemon/bulbasaur":
+			w.Write([]byte(`{"name": "bulbasaur", "species": {"name": "bulbasaur"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	bulbasaur := caughtPokemon{}
+	bulbasaur.Species.Name = "bulbasaur"
+	pokedex = map[string]caughtPokemon{"bulbasaur": bulbasaur}
+	seen = map[string]bool{"bulbasaur": true, "pidgey": true, "pikachu-original-cap": true, "missingno": true}
+	defer func() {
+		pokedex = map[string]caughtPokemon{}
+		seen = map[string]bool{}
+	}()
+
+	var err error
+	output := captureStdout(t, func() {
+		err = commandPokedex(context.Background(), config, "kanto")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"Kanto: 1/5 caught, 3 seen",
+		"Missing:",
+		" #004 charmander",
+		" #007 squirtle",
+		" #016 pidgey (seen)",
+		" #025 pikachu (seen)",
+		"Unknown seen Pokemon (not counted): missingno",
+		"",
+	}, "\n")
+	if output != expected {
+		t.Errorf("commandPokedex(kanto) printed\n%s\nexpected\n%s", output, expected)
+	}
+
+	expectedRequests := []string{"/pokedex/kanto", "/pokemon/missingno", "/pokemon/pikachu-original-cap"}
+	slices.Sort(requested)
+	if !slices.Equal(requested, expectedRequests) {
+		t.Errorf("expected only forms to be looked up, got requests %v", requested)
+	}
+
+	if err := commandPokedex(context.Background(), config, "jhoto"); err == nil || err.Error() != "no Pokedex named jhoto" {
+		t.Errorf("expected unknown Pokedex error, got %v", err)
+	}
+}
+
+func TestCatchRate(t *testing.T) {
+	cases := []struct {
+		captureRate int
+		ball        string
+		expected    int
+	}{
+		{captureRate: 45, ball: "poke-ball", expected: 45},
+		{captureRate: 45, ball: "great-ball", expected: 67},
+		{captureRate: 45, ball: "ultra-ball", expected: 90},
+		{captureRate: 3, ball: "master-ball", expected: 765},
+		{captureRate: 190, ball: "premier-ball", expected: 190},
+	}
+
+	for _, c := range cases {
+		actual := catchRate(c.captureRate, c.ball)
+		if actual != c.expected {
+			t.Errorf("catchRate(%d, %s) == %d, expected %d", c.captureRate, c.ball, actual, c.expected)
+		}
+	}
+	if catchRate(3, "master-ball") < PokeballBaseRate {
+		t.Errorf("expected a master-ball to always catch")
+	}
+}
+
+func TestCommandAbility(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ability/static":
+			w.Write([]byte(`{"name": "static",
+				"effect_entries": [{"short_effect": "Has a 30% chance of paralyzing attacking Pokémon on contact.", "language": {"name": "en"}}],
+				"pokemon": [
+					{"is_hidden": false, "slot": 1, "pokemon": {"name": "pikachu"}},
+					{"is_hidden": true, "slot": 3, "pokemon": {"name": "pichu"}}
+				]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	var err error
+	output := captureStdout(t, func() {
+		err = commandAbility(context.Background(), testConfig, "static")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Name: static\n" +
+		"Effect: Has a 30% chance of paralyzing attacking Pokémon on contact.\n" +
+		"Pokemon with this ability:\n" +
+		" - pikachu\n" +
+		" - pichu (hidden)\n"
+	if output != expected {
+		t.Errorf("commandAbility(static) printed\n%s\nexpected\n%s", output, expected)
+	}
+
+	if err := commandAbility(context.Background(), testConfig, "statik"); err == nil || err.Error() != "no ability named statik" {
+		t.Errorf("expected unknown ability error, got %v", err)
+	}
+}
+
+func TestCommandInspectAbilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon-species/25/":
+			fmt.Fprintf(w, `{"id": 25, "name": "pikachu", "gender_rate": 4, "hatch_counter": 10,
+				"growth_rate": {"name": "medium", "url": "http://%s/growth-rate/2/"}}`, r.Host)
+		case "/growth-rate/2/":
+			w.Write([]byte(`{"name": "medium"}`))
+		case "/ability/9/":
+			w.Write([]byte(`{"name": "static", "effect_entries": [{"short_effect": "May paralyze on contact.", "language": {"name": "en"}}]}`))
+		case "/ability/31/":
+			w.Write([]byte(`{"name": "lightning-rod", "flavor_text_entries": [{"flavor_text": "Draws in all\nElectric-type moves.", "language": {"name": "en"}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	var pikachu pokeapi.PokemonDTO
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{
+		"name": "pikachu",
+		"species": {"name": "pikachu", "url": "%[1]s/pokemon-species/25/"},
+		"abilities": [
+			{"is_hidden": false, "slot": 1, "ability": {"name": "static", "url": "%[1]s/ability/9/"}},
+			{"is_hidden": true, "slot": 3, "ability": {"name": "lightning-rod", "url": "%[1]s/ability/31/"}}
+		]
+	}`, server.URL)), &pikachu)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+	pokedex = map[string]caughtPokemon{"pikachu": {PokemonDTO: pikachu}}
+	defer func() { pokedex = map[string]caughtPokemon{} }()
+
+	output := captureStdout(t, func() {
+		err = commandInspect(context.Background(), testConfig, "pikachu")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Abilities:\n" +
+		" - 1: static: May paralyze on contact.\n" +
+		" - 3: lightning-rod (hidden): Draws in all Electric-type moves.\n"
+	if !strings.Contains(output, expected) {
+		t.Errorf("commandInspect(pikachu) printed\n%s\nexpected it to contain\n%s", output, expected)
+	}
+}
+
+func TestCommandBerry(t *testing.T) {
+	requested := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/berry/razz":
+			fmt.Fprintf(w, `{"name": "razz", "growth_time": 2, "max_harvest": 10, "natural_gift_power": 60, "size": 120, "smoothness": 20,
+				"firmness": {"name": "very-hard", "url": "http://%[1]s/berry-firmness/4/"},
+				"item": {"name": "razz-berry", "url": "http://%[1]s/item/141/"},
+				"natural_gift_type": {"name": "steel"},
+				"flavors": [
+					{"potency": 10, "flavor": {"name": "spicy", "url": "http://%[1]s/berry-flavor/1/"}},
+					{"potency": 0, "flavor": {"name": "dry", "url": "http://%[1]s/berry-flavor/2/"}}
+				]}`, r.Host)
+		case "/berry-firmness/4/":
+			w.Write([]byte(`{"name": "very-hard", "names": [{"name": "Very Hard", "language": {"name": "en"}}]}`))
+		case "/item/141/":
+			w.Write([]byte(`{"name": "razz-berry"}`))
+		case "/berry-flavor/1/":
+			w.Write([]byte(`{"name": "spicy", "contest_type": {"name": "cool"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	var err error
+	output := captureStdout(t, func() {
+		err = commandBerry(context.Background(), testConfig, "razz-berry")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requested[0] != "/berry/razz" {
+		t.Errorf("expected /berry/razz to be fetched first, got %v", requested)
+	}
+
+	expected := "Name: razz-berry\n" +
+		"Firmness: Very Hard\n" +
+		"Size: 120 mm\n" +
+		"Smoothness: 20\n" +
+		"Growth time: 2 hours per stage\n" +
+		"Max harvest: 10\n" +
+		"Natural Gift: steel, power 60\n" +
+		"Flavors:\n" +
+		" - spicy (cool): 10\n"
+	if output != expected {
+		t.Errorf("commandBerry(razz-berry) printed\n%s\nexpected\n%s", output, expected)
+	}
+
+	if err := commandBerry(context.Background(), testConfig, "rawst"); err == nil || err.Error() != "no berry named rawst" {
+		t.Errorf("expected unknown berry error, got %v", err)
+	}
+}
+
+func TestCommandStatsNature(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			w.Write([]byte(`{"name": "pikachu", "stats": [
+				{"base_stat": 35, "stat": {"name": "hp"}},
+				{"base_stat": 55, "stat": {"name": "attack"}},
+				{"base_stat": 40, "stat": {"name": "defense"}},
+				{"base_stat": 50, "stat": {"name": "special-attack"}},
+				{"base_stat": 50, "stat": {"name": "special-defense"}},
+				{"base_stat": 90, "stat": {"name": "speed"}}
+			]}`))
+		case "/stat/hp":
+			w.Write([]byte(`{"name": "hp", "characteristics": []}`))
+		case "/nature/hardy":
+			w.Write([]byte(`{"name": "hardy", "increased_stat": {"name": "attack"}, "decreased_stat": {"name": "attack"}}`))
+		case "/nature/adamant":
+			w.Write([]byte(`{"name": "adamant", "increased_stat": {"name": "attack"}, "decreased_stat": {"name": "special-attack"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		PokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL)),
+	}
+
+	cases := []struct {
+		nature   string
+		expected string
+	}{
+		{nature: "hardy", expected: "Stats of pikachu at level 50 (hardy):\n"},
+		{nature: "adamant", expected: "Stats of pikachu at level 50 (adamant: +attack, -special-attack):\n"},
+	}
+
+	for _, c := range cases {
+		var err error
+		output := captureStdout(t, func() {
+			err = commandStats(context.Background(), testConfig, "pikachu", "--nature", c.nature)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(output, c.expected) {
+			t.Errorf("commandStats(--nature %s) printed\n%s\nexpected it to start with\n%s", c.nature, output, c.expected)
+		}
+	}
+}